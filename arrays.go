@@ -11,8 +11,19 @@ const (
 	emptyArrayErrorMsg     = "%v cannot be empty"
 )
 
+// Codes of the array validations, see [ValidationError]
+const (
+	CodeNotAnArray     = "array.type"
+	CodeArrayNonEmpty  = "array.nonempty"
+	CodeArrayMinLength = "array.min_length"
+	CodeArrayMaxLength = "array.max_length"
+	CodeArrayLength    = "array.length"
+)
+
 const (
 	arrayElementFieldName = "%v's elements"
+	arrayElementPath      = "%v[%d]"
+	arrayElementsPath     = "%v[]"
 )
 
 type ArrayValidator struct {
@@ -23,9 +34,9 @@ type ArrayValidator struct {
 func (v *ArrayValidator) NonEmpty(msg ...string) *ArrayValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if v.field.Len() == 0 {
-			return newValidationError(emptyArrayErrorMsg, cmsg, v.fieldName)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Len() == 0 {
+			return newValidationError(CodeArrayNonEmpty, nil, emptyArrayErrorMsg, cmsg, e.fieldName)
 		}
 		return nil
 	})
@@ -36,9 +47,9 @@ func (v *ArrayValidator) NonEmpty(msg ...string) *ArrayValidator {
 func (v *ArrayValidator) MinLength(min int, msg ...string) *ArrayValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if v.field.Len() < min {
-			return newValidationError(arrayMinLengthErrorMsg, cmsg, v.fieldName, min)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Len() < min {
+			return newValidationError(CodeArrayMinLength, map[string]any{"min": min}, arrayMinLengthErrorMsg, cmsg, e.fieldName, min)
 		}
 		return nil
 	})
@@ -50,9 +61,9 @@ func (v *ArrayValidator) MinLength(min int, msg ...string) *ArrayValidator {
 func (v *ArrayValidator) MaxLength(max int, msg ...string) *ArrayValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if v.field.Len() > max {
-			return newValidationError(arrayMinLengthErrorMsg, cmsg, v.fieldName, max)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Len() > max {
+			return newValidationError(CodeArrayMaxLength, map[string]any{"max": max}, arrayMinLengthErrorMsg, cmsg, e.fieldName, max)
 		}
 		return nil
 	})
@@ -64,9 +75,9 @@ func (v *ArrayValidator) MaxLength(max int, msg ...string) *ArrayValidator {
 func (v *ArrayValidator) Length(length int, msg ...string) *ArrayValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if v.field.Len() != length {
-			return newValidationError(arrayMinLengthErrorMsg, cmsg, v.fieldName, length)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Len() != length {
+			return newValidationError(CodeArrayLength, map[string]any{"length": length}, arrayMinLengthErrorMsg, cmsg, e.fieldName, length)
 		}
 		return nil
 	})
@@ -82,8 +93,8 @@ func (v *ArrayValidator) Length(length int, msg ...string) *ArrayValidator {
 //
 // The function will receive the context and the array as a [reflect.Value], you can convert it to the correct type using the `Slice` method of the [reflect.Value]
 func (v *ArrayValidator) Test(f CustomValidationFunc[reflect.Value]) *ArrayValidator {
-	v.validations = append(v.validations, func() error {
-		return f(v.ctx, v.field.Slice(0, v.field.Cap()))
+	v.validations = append(v.validations, func(e *evalContext) error {
+		return customError(f(e.ctx, e.field.Slice(0, e.field.Cap())))
 	})
 	return v
 }
@@ -94,9 +105,10 @@ func (v *ArrayValidator) Test(f CustomValidationFunc[reflect.Value]) *ArrayValid
 func (v *BaseValidator) Array(msg ...string) *ArrayValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if v.field.Kind() != reflect.Slice {
-			return newValidationError(notAnArrayErrorMsg, cmsg, v.fieldName)
+	v.typeChecks = append(v.typeChecks, kindCheck("Array()", reflect.Slice))
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Kind() != reflect.Slice {
+			return abort(newValidationError(CodeNotAnArray, nil, notAnArrayErrorMsg, cmsg, e.fieldName))
 		}
 		return nil
 	})
@@ -117,22 +129,28 @@ func (v *BaseValidator) Array(msg ...string) *ArrayValidator {
 //
 //	"Users": corretto.Field().Array().Of(corretto.Field("User").Schema(s)),
 func (v *ArrayValidator) Of(validator validator) *ArrayValidator {
-	v.validations = append(v.validations, func() error {
-		for i := 0; i < v.field.Len(); i++ {
-			bv := validator.getBaseValidator()
-			bv.field = v.field.Index(i)
-			// If no custom field name is provided, use the struct field name formatted accordingly
-			if bv.fieldName == "" {
-				bv.fieldName = fmt.Sprintf(arrayElementFieldName, v.fieldName)
-			}
-			bv.ctx = v.ctx
-
-			// If any of the elements fail the validation, return the error
-			if err := bv.check(); err != nil {
-				return err
+	v.elements = validator
+	v.typeChecks = append(v.typeChecks, func(t reflect.Type, path string) error {
+		// The elements of []any are checked only when parsing
+		if t.Kind() != reflect.Slice || t.Elem().Kind() == reflect.Interface {
+			return nil
+		}
+		return validator.getBaseValidator().compile(t.Elem(), fmt.Sprintf(arrayElementsPath, path))
+	})
+	v.validations = append(v.validations, func(e *evalContext) error {
+		var errs ValidationErrors
+		bv := validator.getBaseValidator()
+		for i := 0; i < e.field.Len(); i++ {
+			el := e.element(bv, e.field.Index(i), fmt.Sprintf(arrayElementPath, e.path, i), fmt.Sprintf(arrayElementFieldName, e.fieldName))
+			if err := bv.check(el); err != nil {
+				// If any of the elements fail the validation, return the error
+				if !e.all || isSchemaDefinitionError(err) {
+					return err
+				}
+				errs.add(err)
 			}
 		}
-		return nil
+		return errs.err()
 	})
 
 	return v
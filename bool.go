@@ -4,6 +4,9 @@ import "reflect"
 
 const notABoolErrorMsg = "field %s is not a boolean"
 
+// CodeNotABool is the [ValidationError.Code] reported when the field is not a boolean
+const CodeNotABool = "bool.type"
+
 type BoolValidator struct {
 	*BaseValidator
 }
@@ -12,9 +15,10 @@ type BoolValidator struct {
 func (v *BaseValidator) Bool(msg ...string) *BoolValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if v.field.Kind() != reflect.Bool {
-			return newValidationError(notABoolErrorMsg, cmsg, v.fieldName)
+	v.typeChecks = append(v.typeChecks, kindCheck("Bool()", reflect.Bool))
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Kind() != reflect.Bool {
+			return abort(newValidationError(CodeNotABool, nil, notABoolErrorMsg, cmsg, e.fieldName))
 		}
 		return nil
 	})
@@ -28,8 +32,21 @@ func (v *BaseValidator) Bool(msg ...string) *BoolValidator {
 //
 //	func(ctx corretto.Context, value bool) error
 func (v *BoolValidator) Test(f CustomValidationFunc[bool]) *BoolValidator {
-	v.validations = append(v.validations, func() error {
-		return f(v.ctx, v.field.Bool())
+	v.validations = append(v.validations, func(e *evalContext) error {
+		return customError(f(e.ctx, e.field.Bool()))
 	})
 	return v
 }
+
+// Default sets the field to the provided value when it's false or a nil pointer, before running the validations
+//
+// Since false is the zero value of a bool, use a *bool field to tell an explicit false apart from a missing value
+func (v *BoolValidator) Default(value bool) *BoolValidator {
+	return v.DefaultFunc(func() bool { return value })
+}
+
+// DefaultFunc behaves the same as [BoolValidator.Default] but the value is computed by f every time it's needed
+func (v *BoolValidator) DefaultFunc(f func() bool) *BoolValidator {
+	v.setDefault(func() any { return f() }, reflect.TypeFor[bool]())
+	return v
+}
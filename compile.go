@@ -0,0 +1,210 @@
+package corretto
+
+import (
+	"reflect"
+	"slices"
+)
+
+// typeCheck verifies ahead of time that a rule can be used on fields of the given type, see [Schema.Compile]
+type typeCheck func(t reflect.Type, path string) error
+
+// CompiledSchema is a [Schema] checked against a struct type, see [Schema.Compile]
+//
+// It resolves the fields of the struct, and of the nested structs, only once, so it's faster than parsing with the [Schema] directly.
+// Like the schema, it can be safely used by multiple goroutines at once
+type CompiledSchema struct {
+	t      reflect.Type
+	schema ObjectSchema
+	fields []schemaField
+	nested resolvedFields // The fields of the nested structs, see [ObjectSchema.resolveFields]
+}
+
+// resolvedFields holds the fields of the struct types validated by the schemas, resolved by [Schema.Compile]
+type resolvedFields map[fieldsKey][]schemaField
+
+// fieldsKey identifies the fields of a struct type validated by a schema
+type fieldsKey struct {
+	schema uintptr // The pointer of the [Schema] map
+	t      reflect.Type
+}
+
+func fieldsKeyOf(s Schema, t reflect.Type) fieldsKey {
+	return fieldsKey{reflect.ValueOf(s).Pointer(), t}
+}
+
+// Compile checks the schema against the provided struct type, so that errors in the schema definition
+// can be caught at startup instead of on the first parse. It verifies that:
+//
+//   - every key of the schema is an exported field of the struct
+//   - primitive validators ([BaseValidator.String], [BaseValidator.Number], ...) match the type of the field
+//   - nested schemas ([BaseValidator.Schema]) and array elements validators ([ArrayValidator.Of]) match the type of the elements
+//
+// Nested schemas on fields of interface type can only be checked when parsing, so they are always accepted.
+// It returns a [SchemaDefinitionError] if any of the checks fail.
+//
+// Example:
+//
+//	compiled, err := schema.Compile(reflect.TypeOf(User{}))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	err = compiled.Parse(user)
+func (s Schema) Compile(t reflect.Type) (*CompiledSchema, error) {
+	return s.object().Compile(t)
+}
+
+// Compile behaves the same as [Schema.Compile]
+func (o ObjectSchema) Compile(t reflect.Type) (*CompiledSchema, error) {
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	// The fields are resolved once, a lazy schema at the root only defers the schema it resolves to
+	panics := o.options.panics
+	o = o.resolve()
+	o.options.panics = o.options.panics || panics
+	if err := o.compile(t, ""); err != nil {
+		return nil, err
+	}
+
+	fields, err := o.schema.fields(t, "")
+	if err != nil {
+		return nil, err
+	}
+
+	nested := resolvedFields{}
+	o.resolveFields(t, nested)
+
+	return &CompiledSchema{t: t, schema: o, fields: fields, nested: nested}, nil
+}
+
+// CompileFor behaves the same as [Schema.Compile] using T as the struct type
+//
+//	compiled, err := corretto.CompileFor[User](schema)
+func CompileFor[T any](s Shape) (*CompiledSchema, error) {
+	return s.object().Compile(reflect.TypeFor[T]())
+}
+
+// compile checks the schema against the struct type, the path is the one of the struct
+func (o ObjectSchema) compile(t reflect.Type, path string) error {
+	if o.options.lazy != nil {
+		return o.options.lazy.compile(t, path)
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return newSchemaDefinitionError(path, "schema can only be used with structs, got %v", t)
+	}
+
+	fields, err := o.schema.fields(t, path)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		fieldPath := joinPath(path, f.key)
+		sf := t.FieldByIndex(f.index)
+		if !sf.IsExported() {
+			return newSchemaDefinitionError(fieldPath, "field %s of struct %s must be exported", f.key, t.Name())
+		}
+		if err := f.validator.getBaseValidator().compile(sf.Type, fieldPath); err != nil {
+			return err
+		}
+	}
+
+	for _, check := range o.options.typeChecks {
+		if err := check(t, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compile runs all the type checks of the validator against the type of the field
+// Pointers are dereferenced, since they are when parsing too
+func (v *BaseValidator) compile(t reflect.Type, path string) error {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for _, check := range v.typeChecks {
+		if err := check(t, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveFields stores the fields of the struct type, and of the nested structs the schema validates, in resolved
+// Lazy schemas are resolved, and a recursive type is resolved only once
+func (o ObjectSchema) resolveFields(t reflect.Type, resolved resolvedFields) {
+	o = o.resolve()
+	key := fieldsKeyOf(o.schema, t)
+	if _, ok := resolved[key]; ok {
+		return
+	}
+	fields, err := o.schema.fields(t, "")
+	if err != nil {
+		// Already reported by compile, e.g. for the types checked only when parsing
+		return
+	}
+
+	resolved[key] = fields
+	for _, f := range fields {
+		f.validator.getBaseValidator().resolveFields(t.FieldByIndex(f.index).Type, resolved)
+	}
+}
+
+// resolveFields stores the fields of the structs validated by the nested schema of the field, or of its elements,
+// see [ObjectSchema.resolveFields]
+func (v *BaseValidator) resolveFields(t reflect.Type, resolved resolvedFields) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if v.schema != nil && t.Kind() == reflect.Struct {
+		v.schema.resolveFields(t, resolved)
+	}
+	if v.elements != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Map) {
+		v.elements.getBaseValidator().resolveFields(t.Elem(), resolved)
+	}
+}
+
+// kindCheck returns a [typeCheck] that accepts only fields of the provided kinds
+func kindCheck(rule string, kinds ...reflect.Kind) typeCheck {
+	return func(t reflect.Type, path string) error {
+		if !slices.Contains(kinds, t.Kind()) {
+			return newSchemaDefinitionError(path, "%s can't be used on field %s of type %v", rule, path, t)
+		}
+		return nil
+	}
+}
+
+// Parse behaves the same as [Schema.Parse]
+//
+// It returns a [SchemaDefinitionError] if the value is not of the type the schema was compiled for
+func (c *CompiledSchema) Parse(value any) error {
+	return c.schema.result(c.parse(value, rootContext(value, false)))
+}
+
+// ParseAll behaves the same as [Schema.ParseAll]
+func (c *CompiledSchema) ParseAll(value any) error {
+	return c.schema.result(c.parse(value, rootContext(value, true)))
+}
+
+// MustParse behaves the same as [CompiledSchema.Parse] but panics if any of the validations fail
+func (c *CompiledSchema) MustParse(value any) {
+	err := c.Parse(value)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (c *CompiledSchema) parse(value any, parent *evalContext) error {
+	t, v, err := structOf(value, parent.path)
+	if err != nil {
+		return err
+	}
+	if t != c.t {
+		return newSchemaDefinitionError(parent.path, "schema compiled for %v can't be used with %v", c.t, t)
+	}
+
+	parent.fields = c.nested
+	return c.schema.runChecks(value, parent, parseFields(value, v, c.fields, parent))
+}
@@ -0,0 +1,196 @@
+package corretto
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCompile(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	type User struct {
+		Name      string
+		Age       int
+		Active    bool
+		Tags      []string
+		Address   *Address
+		Addresses []Address
+		Extra     any
+		secret    string
+	}
+
+	tests := []struct {
+		name        string
+		schema      Schema
+		expectError bool
+		path        string
+	}{
+		{
+			name: "valid schema",
+			schema: Schema{
+				"Name":      Field().String().NonEmpty(),
+				"Age":       Field().Number().Min(18),
+				"Active":    Field().Bool(),
+				"Tags":      Field().Array().Of(Field().String().NonEmpty()),
+				"Address":   Field().Schema(Schema{"City": Field().String()}),
+				"Addresses": Field().Array().Of(Field().Schema(Schema{"City": Field().String()})),
+				"Extra":     Field().Schema(Schema{"City": Field().String()}),
+			},
+			expectError: false,
+		},
+		{
+			name:        "unknown field",
+			schema:      Schema{"Unknown": Field().String()},
+			expectError: true,
+			path:        "Unknown",
+		},
+		{
+			name:        "unexported field",
+			schema:      Schema{"secret": Field().String()},
+			expectError: true,
+			path:        "secret",
+		},
+		{
+			name:        "primitive mismatch",
+			schema:      Schema{"Age": Field().String()},
+			expectError: true,
+			path:        "Age",
+		},
+		{
+			name:        "primitive on interface",
+			schema:      Schema{"Extra": Field().String()},
+			expectError: true,
+			path:        "Extra",
+		},
+		{
+			name:        "array elements mismatch",
+			schema:      Schema{"Tags": Field().Array().Of(Field().Number())},
+			expectError: true,
+			path:        "Tags[]",
+		},
+		{
+			name:        "nested schema mismatch",
+			schema:      Schema{"Address": Field().Schema(Schema{"City": Field().Bool()})},
+			expectError: true,
+			path:        "Address.City",
+		},
+		{
+			name:        "nested schema in array mismatch",
+			schema:      Schema{"Addresses": Field().Array().Of(Field().Schema(Schema{"Street": Field().String()}))},
+			expectError: true,
+			path:        "Addresses[].Street",
+		},
+		{
+			name:        "schema on non struct",
+			schema:      Schema{"Name": Field().Schema(Schema{})},
+			expectError: true,
+			path:        "Name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.schema.Compile(reflect.TypeOf(User{}))
+			if !tt.expectError {
+				if err != nil {
+					t.Errorf("Compile() should have returned nil, got %v", err)
+				}
+				return
+			}
+
+			var defErr *SchemaDefinitionError
+			if !errors.As(err, &defErr) {
+				t.Fatalf("Compile() should have returned a SchemaDefinitionError, got %v", err)
+			}
+			if defErr.Path != tt.path {
+				t.Errorf("expected path %s, got %s", tt.path, defErr.Path)
+			}
+		})
+	}
+}
+
+func TestCompiledSchemaParse(t *testing.T) {
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	compiled, err := CompileFor[User](Schema{
+		"Name": Field().String().NonEmpty(),
+		"Age":  Field().Number().Min(18),
+	})
+	if err != nil {
+		t.Fatalf("CompileFor() should have returned nil, got %v", err)
+	}
+
+	t.Run("accepts both pointers and values", func(t *testing.T) {
+		if err := compiled.Parse(User{Name: "John", Age: 30}); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+		if err := compiled.Parse(&User{Name: "John", Age: 30}); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+	})
+
+	t.Run("validates the fields", func(t *testing.T) {
+		err := compiled.ParseAll(User{Name: "", Age: 12})
+
+		var errs ValidationErrors
+		if !errors.As(err, &errs) || len(errs) != 2 {
+			t.Errorf("ParseAll() should have returned 2 errors, got %v", err)
+		}
+	})
+
+	t.Run("rejects values of a different type", func(t *testing.T) {
+		err := compiled.Parse(struct{ Name string }{Name: "John"})
+
+		var defErr *SchemaDefinitionError
+		if !errors.As(err, &defErr) {
+			t.Errorf("Parse() should have returned a SchemaDefinitionError, got %v", err)
+		}
+	})
+}
+
+func TestCompiledSchemaNestedFields(t *testing.T) {
+	type Address struct {
+		City string
+	}
+	type Category struct {
+		Name     string
+		Address  *Address
+		Children []Category
+	}
+
+	addressSchema := Schema{"City": Field().String().NonEmpty()}
+	var categorySchema Schema
+	categorySchema = Schema{
+		"Name":    Field().String().NonEmpty(),
+		"Address": Field().Optional().Schema(addressSchema),
+		"Children": Field().Array().Of(Field().Schema(Lazy(func() Schema {
+			return categorySchema
+		}))),
+	}
+
+	compiled, err := CompileFor[Category](categorySchema)
+	if err != nil {
+		t.Fatalf("CompileFor() should have returned nil, got %v", err)
+	}
+
+	for _, key := range []fieldsKey{
+		fieldsKeyOf(categorySchema, reflect.TypeFor[Category]()),
+		fieldsKeyOf(addressSchema, reflect.TypeFor[Address]()),
+	} {
+		if _, ok := compiled.nested[key]; !ok {
+			t.Errorf("the fields of %v should have been resolved", key.t)
+		}
+	}
+
+	category := Category{Name: "Root", Children: []Category{{Name: "Child", Address: &Address{}}}}
+	var vErr *ValidationError
+	if err := compiled.Parse(category); !errors.As(err, &vErr) || vErr.Path != "Children[0].Address.City" {
+		t.Errorf("expected an error on Children[0].Address.City, got %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+package corretto
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// Extend returns a new schema with the fields of both schemas, if a field is in both the one of other is used.
+// The checks of the whole value of both schemas (e.g. [DiscriminatedUnion]) are kept,
+// while the handling of unknown keys (see [Schema.Strict]) is the one of the schema, use [Schema.Merge] to take the one of other instead
+//
+//	userSchema := nameSchema.Extend(corretto.Schema{
+//		"Age": corretto.Field().Number().Min(18),
+//	})
+//
+// The validators are copied, so changing the returned schema doesn't affect the original ones
+func (s Schema) Extend(other Shape) ObjectSchema {
+	return s.object().Extend(other)
+}
+
+// Merge behaves the same as [Schema.Extend] but the handling of unknown keys of other takes precedence over the one of the schema
+func (s Schema) Merge(other Shape) ObjectSchema {
+	return s.object().Merge(other)
+}
+
+// Pick returns a new schema with only the provided fields, the keys that are not in the schema are ignored
+//
+//	updateSchema := userSchema.Pick("Email", "Nickname")
+func (s Schema) Pick(keys ...string) Schema {
+	return s.clone(func(key string) bool {
+		return slices.Contains(keys, key)
+	})
+}
+
+// Omit returns a new schema without the provided fields, the keys that are not in the schema are ignored
+//
+//	createSchema := userSchema.Omit("ID")
+func (s Schema) Omit(keys ...string) Schema {
+	return s.clone(func(key string) bool {
+		return !slices.Contains(keys, key)
+	})
+}
+
+// Partial returns a new schema where all the fields are optional, see [BaseValidator.Optional]
+//
+//	patchSchema := userSchema.Partial()
+func (s Schema) Partial() Schema {
+	c := s.clone(nil)
+	for _, v := range c {
+		v.getBaseValidator().Optional()
+	}
+	return c
+}
+
+// Required returns a new schema where all the fields are required, see [BaseValidator.Required]
+func (s Schema) Required() Schema {
+	c := s.clone(nil)
+	for _, v := range c {
+		v.getBaseValidator().Required()
+	}
+	return c
+}
+
+// Extend behaves the same as [Schema.Extend]
+func (o ObjectSchema) Extend(other Shape) ObjectSchema {
+	otherObject := other.object()
+	c := o.withFields(fieldsOf(o.schema, otherObject.schema))
+	c.options.checks = append(slices.Clip(o.options.checks), otherObject.options.checks...)
+	c.options.typeChecks = append(slices.Clip(o.options.typeChecks), otherObject.options.typeChecks...)
+	c.options.unions = append(slices.Clip(o.options.unions), otherObject.options.unions...)
+	return c
+}
+
+// Merge behaves the same as [Schema.Merge]
+func (o ObjectSchema) Merge(other Shape) ObjectSchema {
+	c := o.Extend(other)
+	otherOptions := other.object().options
+	c.options.unknownKeys, c.options.unknownKeysMsg = otherOptions.unknownKeys, otherOptions.unknownKeysMsg
+	return c
+}
+
+// Pick behaves the same as [Schema.Pick], the options of the schema are kept
+func (o ObjectSchema) Pick(keys ...string) ObjectSchema {
+	return o.withFields(o.schema.Pick(keys...))
+}
+
+// Omit behaves the same as [Schema.Omit], the options of the schema are kept
+func (o ObjectSchema) Omit(keys ...string) ObjectSchema {
+	return o.withFields(o.schema.Omit(keys...))
+}
+
+// Partial behaves the same as [Schema.Partial], the options of the schema are kept
+func (o ObjectSchema) Partial() ObjectSchema {
+	return o.withFields(o.schema.Partial())
+}
+
+// Required behaves the same as [Schema.Required], the options of the schema are kept
+func (o ObjectSchema) Required() ObjectSchema {
+	return o.withFields(o.schema.Required())
+}
+
+// withFields returns a copy of the object schema with other fields and the same options
+func (o ObjectSchema) withFields(s Schema) ObjectSchema {
+	o.schema = s
+	return o
+}
+
+// fieldsOf returns a copy of the fields of the schemas, the ones of the latter schemas replace the ones with the same key
+func fieldsOf(schemas ...Schema) Schema {
+	c := Schema{}
+	for _, s := range schemas {
+		maps.Copy(c, s.clone(nil))
+	}
+	return c
+}
+
+// clone returns a copy of the schema with the fields accepted by keep (all of them if it's nil)
+func (s Schema) clone(keep func(key string) bool) Schema {
+	c := make(Schema, len(s))
+	for key, v := range s {
+		if keep == nil || keep(key) {
+			c[key] = cloneValidator(v)
+		}
+	}
+	return c
+}
+
+// clone returns a copy of the validator, its rules can be extended without affecting the original one
+func (v *BaseValidator) clone() *BaseValidator {
+	c := *v
+	c.validations = slices.Clone(v.validations)
+	c.typeChecks = slices.Clone(v.typeChecks)
+	c.conditions = slices.Clone(v.conditions)
+	return &c
+}
+
+// cloneValidator returns a copy of the validator with the same type, e.g. a *StringValidator for a *StringValidator,
+// so that it can still be type asserted and extended
+func cloneValidator(v validator) validator {
+	if v, ok := v.(*BaseValidator); ok {
+		return v.clone()
+	}
+
+	// Typed validators embed the *BaseValidator, replace it with a copy
+	rv := reflect.ValueOf(v).Elem()
+	c := reflect.New(rv.Type())
+	c.Elem().Set(rv)
+	c.Elem().FieldByName("BaseValidator").Set(reflect.ValueOf(v.getBaseValidator().clone()))
+	return c.Interface().(validator)
+}
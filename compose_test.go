@@ -0,0 +1,134 @@
+package corretto
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestCompose(t *testing.T) {
+	type User struct {
+		ID       int
+		Name     *string
+		Email    *string
+		Nickname *string
+	}
+
+	name := "John"
+	email := "john@doe.com"
+
+	base := func() Schema {
+		return Schema{
+			"ID":       Field().Number().Positive(),
+			"Name":     Field().String().MinLength(3),
+			"Email":    Field().String().Email(),
+			"Nickname": Field().Optional().String().MinLength(3),
+		}
+	}
+
+	keysOf := func(s Shape) []string {
+		return s.object().schema.keys(nil)
+	}
+
+	t.Run("extend", func(t *testing.T) {
+		s := base()
+		extended := s.Pick("Name").Extend(Schema{"Email": Field().String().Email(), "Name": Field().String().MinLength(10)})
+
+		if !slices.Equal(keysOf(extended), []string{"Email", "Name"}) {
+			t.Errorf("unexpected keys %v", keysOf(extended))
+		}
+		if err := extended.Parse(User{Name: &name, Email: &email}); err == nil {
+			t.Errorf("Parse() should have used the Name of the other schema")
+		}
+		if len(s) != 4 {
+			t.Errorf("Extend() should not have changed the original schema")
+		}
+	})
+
+	t.Run("merge takes the options of the other schema", func(t *testing.T) {
+		doc := map[string]any{"Name": "John", "Admin": true}
+
+		if err := base().Pick("Name").Extend(Schema{}.Strict()).Parse(doc); err != nil {
+			t.Errorf("Extend() should have kept the options of the schema, got %v", err)
+		}
+		if err := base().Pick("Name").Merge(Schema{}.Strict()).Parse(doc); err == nil {
+			t.Errorf("Merge() should have used the options of the other schema")
+		}
+	})
+
+	t.Run("pick and omit", func(t *testing.T) {
+		s := base().Strict()
+
+		if keys := keysOf(s.Pick("Name", "Email", "Unknown")); !slices.Equal(keys, []string{"Email", "Name"}) {
+			t.Errorf("unexpected keys %v", keys)
+		}
+		if keys := keysOf(s.Omit("ID", "Unknown")); !slices.Equal(keys, []string{"Email", "Name", "Nickname"}) {
+			t.Errorf("unexpected keys %v", keys)
+		}
+		if s.Pick("Name").options.unknownKeys != strictUnknownKeys {
+			t.Errorf("Pick() should have kept the options of the schema")
+		}
+	})
+
+	t.Run("partial and required", func(t *testing.T) {
+		s := base()
+
+		if err := s.Omit("ID").Partial().Parse(User{}); err != nil {
+			t.Errorf("Partial() should have made all the fields optional, got %v", err)
+		}
+
+		var vErr *ValidationError
+		if err := s.Parse(User{ID: 1, Name: &name, Email: &email}); err != nil {
+			t.Errorf("Partial() should not have changed the original schema, got %v", err)
+		}
+		if err := s.Required().Parse(User{ID: 1, Name: &name, Email: &email}); !errors.As(err, &vErr) || vErr.Key != "Nickname" || vErr.Code != CodeRequired {
+			t.Errorf("Required() should have made Nickname required, got %v", err)
+		}
+	})
+
+	t.Run("copies keep the type of the validators", func(t *testing.T) {
+		s := base()
+		c := s.Pick("Name")
+
+		v, ok := c["Name"].(*StringValidator)
+		if !ok {
+			t.Fatalf("expected a *StringValidator, got %T", c["Name"])
+		}
+		v.MaxLength(3)
+
+		if err := c.Parse(User{Name: &name}); err == nil {
+			t.Errorf("Parse() should have used the new rule")
+		}
+		if err := s.Pick("Name").Parse(User{Name: &name}); err != nil {
+			t.Errorf("the new rule should not have been added to the original schema, got %v", err)
+		}
+	})
+	t.Run("options are not fields", func(t *testing.T) {
+		s := base().Strict().Refine(func(ctx Context) error { return errors.New("refined") })
+
+		if fields := s.Fields(); len(fields) != 4 {
+			t.Errorf("expected the 4 fields of the schema, got %v", keysOf(fields))
+		}
+
+		c := Schema{}
+		c.Concat(s.Fields())
+		if err := c.Parse(User{ID: 1, Name: &name, Email: &email}); err != nil {
+			t.Errorf("Concat() should have copied only the fields, got %v", err)
+		}
+		if err := s.Parse(User{ID: 1, Name: &name, Email: &email}); err == nil {
+			t.Errorf("the schema should have kept the refinement")
+		}
+	})
+}
+
+func TestEmptyKey(t *testing.T) {
+	s := Schema{"": Field().String().NonEmpty()}.Strict()
+
+	if err := s.Parse(map[string]any{"": "value"}); err != nil {
+		t.Errorf("Parse() should have returned nil, got %v", err)
+	}
+	var vErr *ValidationError
+	if err := s.Parse(map[string]any{"": ""}); !errors.As(err, &vErr) || vErr.Code != CodeStringNonEmpty {
+		t.Errorf("expected a %s error, got %v", CodeStringNonEmpty, err)
+	}
+}
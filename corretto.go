@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"time"
 )
 
 var (
@@ -12,10 +13,24 @@ var (
 )
 
 const (
-	oneOfErrorMsg = "%v must be one of %v"
+	oneOfErrorMsg    = "%v must be one of %v"
+	requiredErrorMsg = "%v is required"
 )
 
-type ValidationFunc func() error
+// CodeRequired is the [ValidationError.Code] reported when a field is nil but it's required, see [BaseValidator.Required]
+const CodeRequired = "required"
+
+// presence defines how a validator handles nil fields
+type presence int
+
+const (
+	presenceRequired presence = iota // nil fields are reported as errors, the default
+	presenceOptional                 // the field may be absent
+	presenceNullable                 // the field may be nil
+)
+
+// validationFunc checks a single rule on the field held by the evaluation context
+type validationFunc func(e *evalContext) error
 
 // Context is the whole struct that contains the field to be validated
 // It can be used to access other fields in the struct and perform validations based on them
@@ -34,7 +49,7 @@ type CustomValidationFunc[V any] func(ctx Context, value V) error
 
 type validator interface {
 	getBaseValidator() *BaseValidator
-	check() error
+	check(e *evalContext) error
 }
 
 // getBaseValidator returns the underlying baseValidator
@@ -44,24 +59,272 @@ func (v *BaseValidator) getBaseValidator() *BaseValidator {
 
 // Check if the field is valid by running all validations
 // If any of the validations fail, return the error
-func (v *BaseValidator) check() error {
+// When all errors are being collected, it keeps running the validations and returns a [ValidationErrors] instead
+//
+// Pointers are dereferenced, so that the validations run on the value they point to.
+// If the field is nil (or a key missing from a map document) the validations are skipped, see [BaseValidator.Optional] and [BaseValidator.Required]
+// A default value (e.g. [StringValidator.Default]) is applied before, so that zero and nil fields are filled first
+func (v *BaseValidator) check(e *evalContext) error {
+	if v.clock != nil {
+		e.clock = v.clock
+	}
+	if v.defaultValue != nil {
+		if err := v.applyDefault(e); err != nil {
+			return err
+		}
+	}
+	if len(v.conditions) > 0 {
+		return v.checkConditions(e)
+	}
+	return v.checkValue(e)
+}
+
+// checkValue runs the validations on the field, handling nil pointers and missing keys
+func (v *BaseValidator) checkValue(e *evalContext) error {
+	// A key missing from a map document, see [Schema.Parse]
+	if !e.field.IsValid() {
+		return v.checkMissing(e)
+	}
+	if e.field.Kind() == reflect.Interface && e.field.IsNil() {
+		return v.checkNil(e)
+	}
+	for e.field.Kind() == reflect.Ptr {
+		if e.field.IsNil() {
+			return v.checkNil(e)
+		}
+		e.field = e.field.Elem()
+	}
+
+	var errs ValidationErrors
 	for _, checkValidation := range v.validations {
-		err := checkValidation()
-		if err != nil {
+		err := checkValidation(e)
+		if err == nil {
+			continue
+		}
+		if defErr, ok := err.(*SchemaDefinitionError); ok {
+			if defErr.Path == "" {
+				defErr.Path = e.path
+			}
+			return err
+		}
+
+		// The field is not of the expected type, the following validations can't be run
+		a, aborted := err.(abortErr)
+		if aborted {
+			err = a.error
+		}
+
+		e.decorate(err)
+		if !e.all {
+			return err
+		}
+		errs.add(err)
+
+		if aborted {
+			break
+		}
+	}
+
+	return errs.err()
+}
+
+// applyDefault sets the field to the default value if it's zero or a nil pointer, allocating the pointers if needed
+//
+// As for transformations (see [evalContext.set]) the value is written back only when a pointer is passed to Parse
+func (v *BaseValidator) applyDefault(e *evalContext) error {
+	field := e.field
+	if !field.IsValid() || field.Kind() == reflect.Interface && field.IsNil() {
+		// A key missing from a map document (or a null one) is set to the default value itself,
+		// since the type of the field doesn't say which concrete type it should have
+		e.field = reflect.ValueOf(v.defaultValue())
+		e.store(field, e.field)
+		return nil
+	}
+	if field.Kind() == reflect.Ptr {
+		for field.Kind() == reflect.Ptr && !field.IsNil() {
+			field = field.Elem()
+		}
+		// A pointer to a zero value is an explicit value, e.g. false for a *bool
+		if field.Kind() != reflect.Ptr {
+			return nil
+		}
+	} else if !field.IsZero() {
+		return nil
+	}
+
+	value := reflect.ValueOf(v.defaultValue())
+	if err := defaultCheck(value.Type())(field.Type(), e.path); err != nil {
+		return err
+	}
+	if n, ok := value.Interface().(float64); ok {
+		if err := fitCheck("default", n, field.Type(), e.path); err != nil {
 			return err
 		}
 	}
 
+	value = filled(field.Type(), value)
+	if !e.store(field, value) {
+		e.field = value
+	}
 	return nil
 }
 
+// defaultCheck returns a [typeCheck] that accepts only fields the default value of type d can be assigned to
+func defaultCheck(d reflect.Type) typeCheck {
+	return func(t reflect.Type, path string) error {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if !d.ConvertibleTo(t) {
+			return newSchemaDefinitionError(path, "default value of type %v can't be used on field %s of type %v", d, path, t)
+		}
+		return nil
+	}
+}
+
+// filled converts the value to the type t, allocating the pointers if t is a pointer type
+func filled(t reflect.Type, value reflect.Value) reflect.Value {
+	if t.Kind() != reflect.Ptr {
+		return value.Convert(t)
+	}
+	p := reflect.New(t.Elem())
+	p.Elem().Set(filled(t.Elem(), value))
+	return p
+}
+
+// checkNil returns an error if the field is nil but the validator requires it
+func (v *BaseValidator) checkNil(e *evalContext) error {
+	if v.presence != presenceRequired {
+		return nil
+	}
+	return v.requiredError(e)
+}
+
+// checkMissing returns an error if the key is missing from the map document but the validator requires it
+//
+// Unlike nil values, missing keys are allowed only by [BaseValidator.Optional]
+func (v *BaseValidator) checkMissing(e *evalContext) error {
+	if v.presence == presenceOptional {
+		return nil
+	}
+	return v.requiredError(e)
+}
+
+// requiredError returns the error of a required field that is nil or missing
+func (v *BaseValidator) requiredError(e *evalContext) error {
+
+	err := newValidationError(CodeRequired, nil, requiredErrorMsg, v.requiredMsg, e.fieldName)
+	e.decorate(err)
+	return err
+}
+
 // Represents a validator for a field
+//
+// A validator only holds the rules to be checked, the state of each validation lives in an [evalContext],
+// this way the same validator (and [Schema]) can be safely used by multiple goroutines at once
 type BaseValidator struct {
-	ctx         Context          // The context of the validation, usually the struct that contains the field
-	fieldName   string           // The name of the field to be displayed in the error message, by default it uses the struct field name
-	field       reflect.Value    // The value of the field to be validated
-	validations []ValidationFunc // The list of validations to be performed
-	key         string           // field name in the struct (and key in the Schema)
+	fieldName    string           // The name of the field to be displayed in the error message, by default it uses the struct field name
+	validations  []validationFunc // The list of validations to be performed
+	typeChecks   []typeCheck      // The checks on the type of the field performed by [Schema.Compile]
+	presence     presence         // How nil fields are handled
+	requiredMsg  string           // The custom message reported when a required field is nil
+	defaultValue func() any       // Computes the value of the field when it's zero or nil, see [BaseValidator.applyDefault]
+	schema       *ObjectSchema    // The schema of the nested struct, see [BaseValidator.Schema]
+	elements     validator        // The validator of the elements, see [ArrayValidator.Of] and [MapValidator.Values]
+	conditions   []validationFunc // The rules that depend on the sibling fields, see [BaseValidator.When]
+	clock        func() time.Time // The clock of the rules relative to the current time, see [TimeValidator.Clock]
+}
+
+// evalContext holds the state of the validation of a single field, it's created every time a field is validated
+type evalContext struct {
+	ctx       Context          // The context of the validation, usually the struct that contains the field
+	fieldName string           // The name of the field to be displayed in the error message
+	field     reflect.Value    // The value of the field to be validated
+	key       string           // field name in the struct (and key in the Schema)
+	path      string           // full path of the field from the root struct, e.g. "Users[3].Address.City"
+	all       bool             // Whether to collect all the errors instead of stopping at the first one
+	depth     int              // The number of lazy schemas the field is nested in, see [Lazy]
+	visiting  map[visit]bool   // The values being validated by the lazy schemas the field is nested in
+	writeBack bool             // Whether transformations and defaults update the value, i.e. a pointer was passed to Parse
+	entry     mapEntry         // The entry of the map holding the field, if it's the value of a map
+	clock     func() time.Time // The clock of the validator running on the field, see [evalContext.now]
+	trial     *trial           // Set while trying an alternative of a union, which must not change the value
+	fields    resolvedFields   // The fields of the nested structs resolved by [CompiledSchema], if it's parsing the value
+}
+
+// trial records whether an alternative of a union would have changed the value, see [firstPassing]
+type trial struct {
+	changed bool
+}
+
+// mapEntry identifies the value of a map, so that it can be replaced with [reflect.Value.SetMapIndex]
+type mapEntry struct {
+	m   reflect.Value
+	key reflect.Value
+}
+
+// rootContext returns the evaluation of the root value passed to Parse, the rest of the evaluations are derived from it
+func rootContext(value any, all bool) *evalContext {
+	return &evalContext{all: all, writeBack: reflect.ValueOf(value).Kind() == reflect.Ptr}
+}
+
+// element creates the evaluation context of an element of the field (e.g. an array element)
+// The name is used only if the validator of the element doesn't have a custom one
+//
+// When the field itself is validated again (e.g. by [BaseValidator.Union] or [BaseValidator.When]) the path is the same, then the evaluation
+// also keeps the map entry holding the field and the clock, so that it behaves the same as the one of the field
+func (e *evalContext) element(v *BaseValidator, field reflect.Value, path string, name string) *evalContext {
+	el := &evalContext{
+		ctx:       e.ctx,
+		field:     unwrap(field),
+		key:       e.key,
+		fieldName: v.fieldName,
+		path:      path,
+		all:       e.all,
+		depth:     e.depth,
+		visiting:  e.visiting,
+		writeBack: e.writeBack,
+		trial:     e.trial,
+		fields:    e.fields,
+	}
+	if path == e.path {
+		el.entry, el.clock = e.entry, e.clock
+	}
+	if el.fieldName == "" {
+		el.fieldName = name
+	}
+	return el
+}
+
+// set replaces the value of the field with the result of a transformation, converting it to the type of the field
+//
+// The value is written back to the struct (or map) only when a pointer is passed to Parse,
+// otherwise only the following validations of the field see the new value
+func (e *evalContext) set(value reflect.Value) {
+	value = value.Convert(e.field.Type())
+	if !e.store(e.field, value) {
+		e.field = value
+	}
+}
+
+// store writes the value back to the field, or to the map entry holding it, if a pointer was passed to Parse
+// It reports whether the field was updated in place, if not the caller has to replace it with the value
+//
+// The struct values of maps are copies, so their fields are never updated
+func (e *evalContext) store(field reflect.Value, value reflect.Value) bool {
+	switch {
+	case !e.writeBack:
+		return false
+	case e.trial != nil:
+		e.trial.changed = true
+		return false
+	case field.CanSet():
+		field.Set(value)
+		return true
+	case e.entry.m.IsValid() && value.Type().AssignableTo(e.entry.m.Type().Elem()):
+		e.entry.m.SetMapIndex(e.entry.key, value)
+	}
+	return false
 }
 
 // Utility to return the first parameter of a variadic function and log a warning if more than one parameter is passed
@@ -85,6 +348,70 @@ func optional[T any](params []T) T {
 	return *new(T)
 }
 
+// unwrap returns the value held by the interface, so that the elements of []any and map[string]any can be validated
+// Nil interfaces are returned as they are
+func unwrap(field reflect.Value) reflect.Value {
+	if field.Kind() == reflect.Interface && !field.IsNil() {
+		return field.Elem()
+	}
+	return field
+}
+
+// siblingOf returns the field with the provided name of the struct (or map document) held by the context, dereferencing pointers.
+// The returned value is invalid if the field is nil or the key is missing from the map document,
+// it returns false only if the context has no such field
+func siblingOf(ctx Context, name string) (reflect.Value, bool) {
+	v := reflect.Indirect(reflect.ValueOf(ctx))
+	switch {
+	case !v.IsValid():
+		return reflect.Value{}, false
+	case isDocument(v.Type()):
+		v = v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+	case v.Kind() == reflect.Struct:
+		f, ok := v.Type().FieldByName(name)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		v = promotedField(v, f.Index)
+	default:
+		return reflect.Value{}, false
+	}
+
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}, true
+		}
+		v = v.Elem()
+	}
+	return v, true
+}
+
+// valueOf returns the value held by the field as an interface
+// Unexported fields can't be converted with [reflect.Value.Interface], so their value is read based on their kind
+func valueOf(field reflect.Value) any {
+	if !field.IsValid() {
+		return nil
+	}
+	if field.CanInterface() {
+		return field.Interface()
+	}
+
+	switch field.Kind() {
+	case reflect.Bool:
+		return field.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return field.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return field.Uint()
+	case reflect.Float32, reflect.Float64:
+		return field.Float()
+	case reflect.String:
+		return field.String()
+	default:
+		return nil
+	}
+}
+
 func oneOf[T comparable](v T, allowed []T) bool {
 	for _, a := range allowed {
 		if v == a {
@@ -108,3 +435,41 @@ func Field(fieldName ...string) *BaseValidator {
 
 	return &BaseValidator{fieldName: name}
 }
+
+// Optional allows the field to be absent, if it's a nil pointer (or a key missing from a map document) all the validations are skipped
+//
+// Pointers are dereferenced automatically, so you can use it with the other validators as usual
+//
+//	type User struct {
+//		Nickname *string
+//	}
+//
+//	"Nickname": corretto.Field().Optional().String().MinLength(3),
+func (v *BaseValidator) Optional() *BaseValidator {
+	v.presence = presenceOptional
+	return v
+}
+
+// Nullable allows the field to be explicitly nil, if it's a nil pointer all the validations are skipped
+//
+// For struct fields a nil pointer is both absent and null, so it behaves the same as [BaseValidator.Optional].
+// For map documents instead it allows nil values, but not missing keys
+func (v *BaseValidator) Nullable() *BaseValidator {
+	v.presence = presenceNullable
+	return v
+}
+
+// setDefault sets the function computing the default value of the field, see [BaseValidator.applyDefault]
+func (v *BaseValidator) setDefault(f func() any, t reflect.Type) {
+	v.defaultValue = f
+	v.typeChecks = append(v.typeChecks, defaultCheck(t))
+}
+
+// Required reports an "is required" error if the field is a nil pointer, skipping the other validations
+//
+// Fields are required by default, use it to customize the error message or to undo a previous [BaseValidator.Optional]
+func (v *BaseValidator) Required(msg ...string) *BaseValidator {
+	v.presence = presenceRequired
+	v.requiredMsg = optional(msg)
+	return v
+}
@@ -1,9 +1,12 @@
 package corretto
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestValidationOpts(t *testing.T) {
@@ -212,3 +215,354 @@ func TestKitchenSink(t *testing.T) {
 		})
 	}
 }
+
+func TestValidationError(t *testing.T) {
+	errCustom := errors.New("custom error")
+
+	type user struct {
+		FirstName string
+		Age       int
+		Hobbies   []string
+	}
+
+	schema := Schema{
+		"FirstName": Field("Name").String().MinLength(3),
+		"Age": Field().Number().Test(func(ctx Context, value int) error {
+			return errCustom
+		}),
+		"Hobbies": Field().Array().Of(Field().String().Email()),
+	}
+
+	err := schema.ParseAll(user{FirstName: "Jo", Age: 20, Hobbies: []string{"not an email"}})
+
+	tests := []struct {
+		name     string
+		key      string
+		expected ValidationError
+	}{
+		{
+			name:     "builtin validation",
+			key:      "FirstName",
+			expected: ValidationError{Key: "FirstName", Field: "Name", Path: "FirstName", Code: CodeStringMinLength, Params: map[string]any{"min": 3}, Value: "Jo", Message: "Name must be at least 3 characters long"},
+		},
+		{
+			name:     "custom validation",
+			key:      "Age",
+			expected: ValidationError{Key: "Age", Field: "Age", Path: "Age", Code: CodeCustom, Value: 20, Message: "custom error", Err: errCustom},
+		},
+		{
+			name:     "array element",
+			key:      "Hobbies",
+			expected: ValidationError{Key: "Hobbies", Field: "Hobbies's elements", Path: "Hobbies[0]", Code: CodeStringEmail, Params: map[string]any{"pattern": emailRegexString}, Value: "not an email", Message: "Hobbies's elements is not in the correct format"},
+		},
+	}
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("ParseAll() should have returned ValidationErrors, got %T", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *ValidationError
+			for _, e := range errs {
+				if vErr, ok := e.(*ValidationError); ok && vErr.Key == tt.key {
+					got = vErr
+				}
+			}
+
+			if got == nil {
+				t.Fatalf("no error found for key %s", tt.key)
+			}
+			if !reflect.DeepEqual(*got, tt.expected) {
+				t.Errorf("expected: %+v, got: %+v", tt.expected, *got)
+			}
+		})
+	}
+
+	t.Run("custom errors can be unwrapped", func(t *testing.T) {
+		if !errors.Is(err, errCustom) {
+			t.Errorf("errors.Is() should have found the custom error")
+		}
+	})
+
+	t.Run("returned validation errors are not modified", func(t *testing.T) {
+		errTaken := &ValidationError{Code: "user.taken", Message: "already taken"}
+		check := func(ctx Context, value string) error {
+			return errTaken
+		}
+		schema := Schema{
+			"Nick":  Field().String().Test(check),
+			"Email": Field().String().Test(check),
+		}.SuperRefine(func(ctx Context, r *Refinement) {
+			r.AddError("Nick", errTaken)
+		})
+
+		errs, ok := schema.ParseAll(struct{ Nick, Email string }{"jd", "jd@doe.com"}).(ValidationErrors)
+		if !ok || len(errs) != 3 {
+			t.Fatalf("expected 3 errors, got %v", errs)
+		}
+		for i, path := range []string{"Nick", "Email", "Nick"} {
+			var vErr *ValidationError
+			if !errors.As(errs[i], &vErr) || vErr.Path != path || vErr.Code != "user.taken" {
+				t.Errorf("expected an error for %s, got %+v", path, errs[i])
+			}
+			if !errors.Is(errs[i], errTaken) {
+				t.Errorf("errors.Is() should have found the returned error")
+			}
+		}
+		if errTaken.Path != "" || errTaken.Field != "" || errTaken.Value != nil {
+			t.Errorf("the returned error should not have been modified, got %+v", errTaken)
+		}
+	})
+}
+
+func TestPresence(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	type User struct {
+		Nickname *string
+		Age      *int
+		Address  *Address
+	}
+
+	name := "Jo"
+	age := 30
+
+	tests := []struct {
+		name        string
+		schema      Schema
+		user        User
+		expectedMsg string
+	}{
+		{
+			name:        "pointers are dereferenced",
+			schema:      Schema{"Nickname": Field().String().MinLength(3), "Age": Field().Number().Min(18)},
+			user:        User{Nickname: &name, Age: &age},
+			expectedMsg: "Nickname must be at least 3 characters long",
+		},
+		{
+			name:        "nil pointers are required by default",
+			schema:      Schema{"Age": Field().Number().Min(18)},
+			user:        User{},
+			expectedMsg: "Age is required",
+		},
+		{
+			name:        "nil nested structs are required by default",
+			schema:      Schema{"Address": Field().Schema(Schema{"City": Field().String().NonEmpty()})},
+			user:        User{},
+			expectedMsg: "Address is required",
+		},
+		{
+			name:        "required with custom message",
+			schema:      Schema{"Age": Field().Required("%v is missing").Number()},
+			user:        User{},
+			expectedMsg: "Age is missing",
+		},
+		{
+			name:   "optional skips the validations",
+			schema: Schema{"Nickname": Field().Optional().String().MinLength(3), "Address": Field().Optional().Schema(Schema{"City": Field().String().NonEmpty()})},
+			user:   User{},
+		},
+		{
+			name:        "optional validates present values",
+			schema:      Schema{"Nickname": Field().Optional().String().MinLength(3)},
+			user:        User{Nickname: &name},
+			expectedMsg: "Nickname must be at least 3 characters long",
+		},
+		{
+			name:   "nullable skips the validations",
+			schema: Schema{"Age": Field().Nullable().Number().Min(18)},
+			user:   User{},
+		},
+		{
+			name:        "required undoes optional",
+			schema:      Schema{"Age": Field().Optional().Required().Number()},
+			user:        User{},
+			expectedMsg: "Age is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schema.Parse(tt.user)
+			if tt.expectedMsg == "" {
+				if err != nil {
+					t.Errorf("Parse() should have returned nil, got %v", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tt.expectedMsg {
+				t.Errorf("expected: %s, got: %v", tt.expectedMsg, err)
+			}
+		})
+	}
+
+	t.Run("required error is structured", func(t *testing.T) {
+		err := Schema{"Age": Field().Number()}.Parse(User{})
+
+		var vErr *ValidationError
+		if !errors.As(err, &vErr) || vErr.Code != CodeRequired || vErr.Path != "Age" {
+			t.Errorf("expected a required error for Age, got %v", err)
+		}
+	})
+
+	t.Run("compiles pointer fields", func(t *testing.T) {
+		_, err := CompileFor[User](Schema{
+			"Nickname": Field().Optional().String(),
+			"Address":  Field().Optional().Schema(Schema{"City": Field().String()}),
+		})
+		if err != nil {
+			t.Errorf("Compile() should have returned nil, got %v", err)
+		}
+	})
+}
+
+func TestDefault(t *testing.T) {
+	type Query struct {
+		Search   string
+		PageSize int
+		Offset   *uint
+		Exact    *bool
+		Since    time.Time
+	}
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	schema := Schema{
+		"Search":   Field().String().Default("*"),
+		"PageSize": Field().Number().Default(20).Max(100),
+		"Offset":   Field().Number().Default(0),
+		"Exact":    Field().Bool().Default(true),
+		"Since":    Field().Time().DefaultFunc(func() time.Time { return since }),
+	}
+
+	t.Run("fills zero and nil fields", func(t *testing.T) {
+		q := &Query{}
+		if err := schema.Parse(q); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+
+		if q.Search != "*" || q.PageSize != 20 || q.Offset == nil || *q.Offset != 0 || q.Exact == nil || !*q.Exact || !q.Since.Equal(since) {
+			t.Errorf("Parse() should have filled the fields, got %+v", q)
+		}
+	})
+
+	t.Run("keeps the provided values", func(t *testing.T) {
+		offset := uint(5)
+		exact := false
+		q := &Query{Search: "go", PageSize: 10, Offset: &offset, Exact: &exact, Since: since.AddDate(1, 0, 0)}
+		if err := schema.Parse(q); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+
+		if q.Search != "go" || q.PageSize != 10 || *q.Offset != 5 || *q.Exact || !q.Since.Equal(since.AddDate(1, 0, 0)) {
+			t.Errorf("Parse() should have kept the values, got %+v", q)
+		}
+	})
+
+	t.Run("validations see the default value", func(t *testing.T) {
+		s := Schema{"PageSize": Field().Number().Default(200).Max(100)}
+
+		var vErr *ValidationError
+		if err := s.Parse(Query{}); !errors.As(err, &vErr) || vErr.Code != CodeNumberMax {
+			t.Errorf("expected a %s error, got %v", CodeNumberMax, err)
+		}
+	})
+
+	t.Run("computes the value lazily", func(t *testing.T) {
+		calls := 0
+		s := Schema{"Search": Field().String().DefaultFunc(func() string {
+			calls++
+			return fmt.Sprintf("search-%d", calls)
+		})}
+
+		q := &Query{}
+		_ = s.Parse(q)
+		_ = s.Parse(&Query{Search: "go"})
+		if calls != 1 || q.Search != "search-1" {
+			t.Errorf("expected DefaultFunc to be called once, got %d calls and %q", calls, q.Search)
+		}
+	})
+
+	t.Run("fills the struct with Unmarshal", func(t *testing.T) {
+		q := &Query{}
+		if err := schema.Unmarshal([]byte(`{"Search": "go"}`), q); err != nil {
+			t.Fatalf("Unmarshal() should have returned nil, got %v", err)
+		}
+		if q.Search != "go" || q.PageSize != 20 {
+			t.Errorf("Unmarshal() should have filled the struct, got %+v", q)
+		}
+	})
+
+	t.Run("fills null keys of map documents", func(t *testing.T) {
+		s := Schema{
+			"PageSize": Field().Number().Default(20),
+			"Order":    Field().String().Default("asc"),
+		}
+
+		var doc map[string]any
+		if err := json.Unmarshal([]byte(`{"PageSize": null, "Order": null}`), &doc); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Parse(&doc); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+		if doc["PageSize"] != 20.0 || doc["Order"] != "asc" {
+			t.Errorf("Parse() should have filled the null keys, got %v", doc)
+		}
+	})
+
+	t.Run("returns SchemaDefinitionError for a mismatching type", func(t *testing.T) {
+		s := Schema{"Search": Field().Number().Default(1)}
+
+		var defErr *SchemaDefinitionError
+		if err := s.Parse(&Query{}); !errors.As(err, &defErr) || defErr.Path != "Search" {
+			t.Errorf("expected a SchemaDefinitionError, got %v", err)
+		}
+		if _, err := CompileFor[Query](s); !errors.As(err, &defErr) {
+			t.Errorf("expected a SchemaDefinitionError from Compile, got %v", err)
+		}
+	})
+
+	t.Run("returns SchemaDefinitionError for numbers that don't fit the field", func(t *testing.T) {
+		type Limits struct {
+			Small uint8
+			Count uint
+			Size  int
+		}
+
+		tests := []struct {
+			name      string
+			key       string
+			validator validator
+		}{
+			{"overflow", "Small", Field().Number().Default(300)},
+			{"negative unsigned", "Count", Field().Number().Default(-1)},
+			{"fractional part", "Size", Field().Number().Default(2.5)},
+			{"computed", "Size", Field().Number().DefaultFunc(func() float64 { return 2.5 })},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				v := &Limits{}
+				var defErr *SchemaDefinitionError
+				if err := (Schema{tt.key: tt.validator}).Parse(v); !errors.As(err, &defErr) || defErr.Path != tt.key {
+					t.Errorf("expected a SchemaDefinitionError, got %v", err)
+				}
+				if *v != (Limits{}) {
+					t.Errorf("Parse() should not have changed the struct, got %+v", *v)
+				}
+			})
+		}
+
+		if _, err := CompileFor[Limits](Schema{"Small": Field().Number().Default(300)}); err == nil {
+			t.Errorf("Compile() should have returned a SchemaDefinitionError")
+		}
+		if _, err := CompileFor[Limits](Schema{"Small": Field().Number().Default(255)}); err != nil {
+			t.Errorf("Compile() should have returned nil, got %v", err)
+		}
+	})
+}
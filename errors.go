@@ -5,15 +5,37 @@ import (
 	"strings"
 )
 
-type validationErr struct {
-	Err error
+// CodeCustom is the [ValidationError.Code] of the errors returned by custom validations (e.g. [StringValidator.Test])
+const CodeCustom = "custom"
+
+// ValidationError describes a single validation that failed.
+// Use [errors.As] to inspect it:
+//
+//	var vErr *corretto.ValidationError
+//	if errors.As(err, &vErr) {
+//		log.Println(vErr.Key, vErr.Code, vErr.Params["min"])
+//	}
+type ValidationError struct {
+	Key     string         // The field name in the struct (and key in the Schema)
+	Field   string         // The name of the field displayed in the message, see [Field]
+	Path    string         // The full path of the field from the root struct, e.g. "Users[3].Address.City"
+	Code    string         // Stable identifier of the rule that failed, e.g. "string.min_length"
+	Params  map[string]any // Parameters of the rule, e.g. {"min": 3} for MinLength(3)
+	Value   any            // The value that failed the validation
+	Message string         // The formatted error message
+	Err     error          // The error returned by a custom validation, if any
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
 }
 
-func (v validationErr) Error() string {
-	return v.Err.Error()
+// Unwrap returns the error returned by a custom validation, if any
+func (e *ValidationError) Unwrap() error {
+	return e.Err
 }
 
-func newValidationError(msg string, cmsg string, args ...any) validationErr {
+func newValidationError(code string, params map[string]any, msg string, cmsg string, args ...any) *ValidationError {
 	if cmsg != "" {
 		msg = cmsg
 	}
@@ -27,5 +49,115 @@ func newValidationError(msg string, cmsg string, args ...any) validationErr {
 		args = args[:numPlaceholders]
 	}
 
-	return validationErr{Err: fmt.Errorf(msg, args...)}
+	return &ValidationError{Code: code, Params: params, Message: fmt.Sprintf(msg, args...)}
+}
+
+// customError wraps the error returned by a custom validation into a [ValidationError]
+//
+// If the error already is a [ValidationError] or a [SchemaDefinitionError] a copy of it is returned, since the field
+// information is filled in later and the same error (e.g. a package-level variable) can be returned by many parses at once.
+// The copy of a [ValidationError] wraps the original one, so that it can still be matched with [errors.Is]
+func customError(err error) error {
+	switch err := err.(type) {
+	case nil:
+		return nil
+	case *ValidationError:
+		c := *err
+		c.Err = err
+		return &c
+	case *SchemaDefinitionError:
+		c := *err
+		return &c
+	}
+	return &ValidationError{Code: CodeCustom, Message: err.Error(), Err: err}
+}
+
+// decorate fills the field information of the errors that don't have it yet
+func (e *evalContext) decorate(err error) {
+	switch vErr := err.(type) {
+	case ValidationErrors:
+		for _, err := range vErr {
+			e.decorate(err)
+		}
+	case *ValidationError:
+		// Errors coming from nested schemas and array elements were already decorated
+		if vErr.Field != "" {
+			return
+		}
+		vErr.Key = e.key
+		vErr.Field = e.fieldName
+		vErr.Path = e.path
+		vErr.Value = valueOf(e.field)
+	}
+}
+
+// SchemaDefinitionError is returned when the schema doesn't match the value being parsed,
+// e.g. a key of the schema is not a field of the struct or a nested schema is used on an unexported field.
+//
+// It signals a bug in the schema rather than invalid data, so it's never mixed with validation errors
+// and the validation stops as soon as it's found. Use [Schema.PanicOnDefinitionError] if you'd rather panic
+type SchemaDefinitionError struct {
+	Path    string // The path of the field the error refers to
+	Message string // The description of the problem
+}
+
+func (e *SchemaDefinitionError) Error() string {
+	return e.Message
+}
+
+func newSchemaDefinitionError(path string, msg string, args ...any) *SchemaDefinitionError {
+	return &SchemaDefinitionError{Path: path, Message: fmt.Sprintf(msg, args...)}
+}
+
+// isSchemaDefinitionError checks if the error is a [SchemaDefinitionError], which must stop the validation
+func isSchemaDefinitionError(err error) bool {
+	_, ok := err.(*SchemaDefinitionError)
+	return ok
+}
+
+// ValidationErrors is returned by [Schema.ParseAll] and lists every validation that failed,
+// including the ones of nested schemas and array elements
+//
+// You can use [errors.As] and [errors.Is] on it to look for a specific error
+type ValidationErrors []error
+
+// Error returns the messages of all the errors, one per line
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the list of errors, it allows [errors.Is] and [errors.As] to inspect each one of them
+func (e ValidationErrors) Unwrap() []error {
+	return e
+}
+
+// add appends the error to the list, flattening it if it's a [ValidationErrors] itself
+func (e *ValidationErrors) add(err error) {
+	if errs, ok := err.(ValidationErrors); ok {
+		*e = append(*e, errs...)
+		return
+	}
+	*e = append(*e, err)
+}
+
+// err returns nil if the list is empty, otherwise the list itself
+func (e ValidationErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// abortErr wraps an error to skip the remaining validations of a field, even when collecting all errors.
+// It's used when the field is not of the type expected by the following validations
+type abortErr struct {
+	error
+}
+
+func abort(err error) abortErr {
+	return abortErr{err}
 }
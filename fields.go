@@ -0,0 +1,204 @@
+package corretto
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+const (
+	equalsFieldErrorMsg      = "%v must be equal to %v"
+	notEqualsFieldErrorMsg   = "%v must not be equal to %v"
+	greaterThanFieldErrorMsg = "%v must be greater than %v"
+	lessThanFieldErrorMsg    = "%v must be less than %v"
+)
+
+// Codes of the comparisons with a sibling field, see [ValidationError]
+// The name of the sibling field is in the "field" param
+const (
+	CodeEqualsField      = "field.equals"
+	CodeNotEqualsField   = "field.not_equals"
+	CodeGreaterThanField = "field.greater_than"
+	CodeLessThanField    = "field.less_than"
+)
+
+// fieldComparison is a rule comparing the field with a sibling field
+type fieldComparison struct {
+	rule string             // The name of the rule, used in the schema definition errors
+	code string             // The code of the error
+	ok   func(cmp int) bool // Whether the result of the comparison passes the rule
+}
+
+var (
+	equalsField      = fieldComparison{"EqualsField()", CodeEqualsField, func(c int) bool { return c == 0 }}
+	notEqualsField   = fieldComparison{"NotEqualsField()", CodeNotEqualsField, func(c int) bool { return c != 0 }}
+	greaterThanField = fieldComparison{"GreaterThanField()", CodeGreaterThanField, func(c int) bool { return c > 0 }}
+	lessThanField    = fieldComparison{"LessThanField()", CodeLessThanField, func(c int) bool { return c < 0 }}
+)
+
+// compareField returns the validation comparing the field with the sibling field with the provided name (see [siblingOf])
+//
+// The compare function returns -1, 0 or +1 as [cmp.Compare] does, and false if the sibling can't be compared with the field.
+// If the sibling is nil (or a key missing from a map document) the validation is skipped, its own rules report whether it's required
+func compareField(c fieldComparison, other string, msg string, cmsg string, compare func(field, sibling reflect.Value) (int, bool)) validationFunc {
+	return func(e *evalContext) error {
+		sibling, ok := siblingOf(e.ctx, other)
+		if !ok {
+			return newSchemaDefinitionError(e.path, "%s refers to field %s not found in %T", c.rule, other, e.ctx)
+		}
+		if !sibling.IsValid() {
+			return nil
+		}
+
+		result, ok := compare(e.field, sibling)
+		if !ok {
+			return newSchemaDefinitionError(e.path, "%s can't compare field %s with field %s of type %v", c.rule, e.fieldName, other, sibling.Type())
+		}
+		if !c.ok(result) {
+			return newValidationError(c.code, map[string]any{"field": other}, msg, cmsg, e.fieldName, other)
+		}
+		return nil
+	}
+}
+
+// compareStrings compares the field with a sibling string field
+func compareStrings(field, sibling reflect.Value) (int, bool) {
+	if sibling.Kind() != reflect.String {
+		return 0, false
+	}
+	return strings.Compare(field.String(), sibling.String()), true
+}
+
+// compareNumbers compares the field with a sibling number field, integers are compared exactly, signed and unsigned ones too, and floats as float64
+func compareNumbers(field, sibling reflect.Value) (int, bool) {
+	if sibling.Type() == jsonNumberType {
+		n, ok := jsonNumberOf(sibling.String())
+		if !ok {
+			return 0, false
+		}
+		sibling = n
+	}
+
+	switch {
+	case !slices.Contains(numberKinds, sibling.Kind()):
+		return 0, false
+	case slices.Contains(intKinds, field.Kind()) && slices.Contains(intKinds, sibling.Kind()):
+		return cmp.Compare(field.Int(), sibling.Int()), true
+	case slices.Contains(uintKinds, field.Kind()) && slices.Contains(uintKinds, sibling.Kind()):
+		return cmp.Compare(field.Uint(), sibling.Uint()), true
+	case slices.Contains(intKinds, field.Kind()) && slices.Contains(uintKinds, sibling.Kind()):
+		return compareIntUint(field.Int(), sibling.Uint()), true
+	case slices.Contains(uintKinds, field.Kind()) && slices.Contains(intKinds, sibling.Kind()):
+		return -compareIntUint(sibling.Int(), field.Uint()), true
+	default:
+		return cmp.Compare(floatOf(field), floatOf(sibling)), true
+	}
+}
+
+// compareIntUint compares a signed integer with an unsigned one exactly, a negative integer is less than any unsigned one
+func compareIntUint(i int64, u uint64) int {
+	if i < 0 {
+		return -1
+	}
+	return cmp.Compare(uint64(i), u)
+}
+
+// compareTimes compares the field with a sibling [time.Time] field
+func compareTimes(field, sibling reflect.Value) (int, bool) {
+	if sibling.Type() != timeType || !sibling.CanInterface() {
+		return 0, false
+	}
+	return timeOf(field).Compare(timeOf(sibling)), true
+}
+
+// EqualsField checks if the field is equal to the sibling field with the provided name, e.g. a password confirmation
+//
+//	"ConfirmPassword": corretto.Field().String().EqualsField("Password"),
+//
+// If the sibling is nil the validation is skipped, if the struct has no such field a [SchemaDefinitionError] is returned
+func (v *StringValidator) EqualsField(field string, msg ...string) *StringValidator {
+	v.validations = append(v.validations, compareField(equalsField, field, equalsFieldErrorMsg, optional(msg), compareStrings))
+	return v
+}
+
+// NotEqualsField checks if the field is different from the sibling field with the provided name, see [StringValidator.EqualsField]
+func (v *StringValidator) NotEqualsField(field string, msg ...string) *StringValidator {
+	v.validations = append(v.validations, compareField(notEqualsField, field, notEqualsFieldErrorMsg, optional(msg), compareStrings))
+	return v
+}
+
+// GreaterThanField checks if the field comes strictly after the sibling field with the provided name in lexicographic order,
+// see [StringValidator.EqualsField]
+func (v *StringValidator) GreaterThanField(field string, msg ...string) *StringValidator {
+	v.validations = append(v.validations, compareField(greaterThanField, field, greaterThanFieldErrorMsg, optional(msg), compareStrings))
+	return v
+}
+
+// LessThanField checks if the field comes strictly before the sibling field with the provided name in lexicographic order,
+// see [StringValidator.EqualsField]
+func (v *StringValidator) LessThanField(field string, msg ...string) *StringValidator {
+	v.validations = append(v.validations, compareField(lessThanField, field, lessThanFieldErrorMsg, optional(msg), compareStrings))
+	return v
+}
+
+// EqualsField checks if the field is equal to the sibling field with the provided name, which can be a number of any type
+//
+// If the sibling is nil the validation is skipped, if the struct has no such field a [SchemaDefinitionError] is returned
+func (v *NumberValidator) EqualsField(field string, msg ...string) *NumberValidator {
+	v.validations = append(v.validations, compareField(equalsField, field, equalsFieldErrorMsg, optional(msg), compareNumbers))
+	return v
+}
+
+// NotEqualsField checks if the field is different from the sibling field with the provided name, see [NumberValidator.EqualsField]
+func (v *NumberValidator) NotEqualsField(field string, msg ...string) *NumberValidator {
+	v.validations = append(v.validations, compareField(notEqualsField, field, notEqualsFieldErrorMsg, optional(msg), compareNumbers))
+	return v
+}
+
+// GreaterThanField checks if the field is strictly greater than the sibling field with the provided name,
+// see [NumberValidator.EqualsField]
+//
+//	"Max": corretto.Field().Number().GreaterThanField("Min"),
+func (v *NumberValidator) GreaterThanField(field string, msg ...string) *NumberValidator {
+	v.validations = append(v.validations, compareField(greaterThanField, field, greaterThanFieldErrorMsg, optional(msg), compareNumbers))
+	return v
+}
+
+// LessThanField checks if the field is strictly less than the sibling field with the provided name,
+// see [NumberValidator.EqualsField]
+func (v *NumberValidator) LessThanField(field string, msg ...string) *NumberValidator {
+	v.validations = append(v.validations, compareField(lessThanField, field, lessThanFieldErrorMsg, optional(msg), compareNumbers))
+	return v
+}
+
+// EqualsField checks if the field is the same instant as the sibling field with the provided name, see [time.Time.Equal]
+//
+// If the sibling is nil the validation is skipped, if the struct has no such field a [SchemaDefinitionError] is returned
+func (v *TimeValidator) EqualsField(field string, msg ...string) *TimeValidator {
+	v.validations = append(v.validations, compareField(equalsField, field, equalsFieldErrorMsg, optional(msg), compareTimes))
+	return v
+}
+
+// NotEqualsField checks if the field is a different instant from the sibling field with the provided name,
+// see [TimeValidator.EqualsField]
+func (v *TimeValidator) NotEqualsField(field string, msg ...string) *TimeValidator {
+	v.validations = append(v.validations, compareField(notEqualsField, field, notEqualsFieldErrorMsg, optional(msg), compareTimes))
+	return v
+}
+
+// GreaterThanField checks if the field is strictly after the sibling field with the provided name,
+// see [TimeValidator.EqualsField]
+//
+//	"EndDate": corretto.Field().Time().GreaterThanField("StartDate"),
+func (v *TimeValidator) GreaterThanField(field string, msg ...string) *TimeValidator {
+	v.validations = append(v.validations, compareField(greaterThanField, field, timeAfterErrorMsg, optional(msg), compareTimes))
+	return v
+}
+
+// LessThanField checks if the field is strictly before the sibling field with the provided name,
+// see [TimeValidator.EqualsField]
+func (v *TimeValidator) LessThanField(field string, msg ...string) *TimeValidator {
+	v.validations = append(v.validations, compareField(lessThanField, field, timeBeforeErrorMsg, optional(msg), compareTimes))
+	return v
+}
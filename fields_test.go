@@ -0,0 +1,117 @@
+package corretto
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFieldComparisons(t *testing.T) {
+	type Signup struct {
+		Password        string
+		ConfirmPassword string
+		Username        string
+	}
+	type Range struct {
+		Min   int
+		Max   float64
+		Limit *uint
+	}
+	type Big struct {
+		Signed   int64
+		Unsigned uint64
+	}
+	type Booking struct {
+		StartDate time.Time
+		EndDate   time.Time
+	}
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	limit := uint(10)
+
+	tests := []struct {
+		name   string
+		schema Schema
+		value  any
+		code   string // The code of the expected error, empty if it should pass
+	}{
+		{"strings equal", Schema{"ConfirmPassword": Field().String().EqualsField("Password")}, Signup{Password: "secret", ConfirmPassword: "secret"}, ""},
+		{"strings not equal", Schema{"ConfirmPassword": Field().String().EqualsField("Password")}, Signup{Password: "secret", ConfirmPassword: "other"}, CodeEqualsField},
+		{"strings different", Schema{"Password": Field().String().NotEqualsField("Username")}, Signup{Password: "secret", Username: "john"}, ""},
+		{"strings not different", Schema{"Password": Field().String().NotEqualsField("Username")}, Signup{Password: "john", Username: "john"}, CodeNotEqualsField},
+		{"strings greater", Schema{"Password": Field().String().GreaterThanField("Username")}, Signup{Password: "b", Username: "a"}, ""},
+		{"strings not less", Schema{"Password": Field().String().LessThanField("Username")}, Signup{Password: "b", Username: "a"}, CodeLessThanField},
+		{"numbers of different types", Schema{"Max": Field().Number().GreaterThanField("Min")}, Range{Min: 1, Max: 1.5}, ""},
+		{"numbers not greater", Schema{"Max": Field().Number().GreaterThanField("Min")}, Range{Min: 2, Max: 2}, CodeGreaterThanField},
+		{"numbers less", Schema{"Min": Field().Number().LessThanField("Max")}, Range{Min: 1, Max: 2}, ""},
+		{"numbers pointer sibling", Schema{"Min": Field().Number().LessThanField("Limit")}, Range{Min: 11, Limit: &limit}, CodeLessThanField},
+		{"numbers nil sibling", Schema{"Min": Field().Number().LessThanField("Limit")}, Range{Min: 11}, ""},
+		{"numbers equal", Schema{"Min": Field().Number().EqualsField("Max")}, Range{Min: 3, Max: 3}, ""},
+		{"signed greater than unsigned above 2^53", Schema{"Signed": Field().Number().GreaterThanField("Unsigned")}, Big{Signed: 1<<53 + 1, Unsigned: 1 << 53}, ""},
+		{"unsigned not equal to signed above 2^53", Schema{"Unsigned": Field().Number().EqualsField("Signed")}, Big{Signed: 1<<53 + 1, Unsigned: 1 << 53}, CodeEqualsField},
+		{"negative less than unsigned", Schema{"Signed": Field().Number().LessThanField("Unsigned")}, Big{Signed: -1, Unsigned: math.MaxUint64}, ""},
+		{"numbers not different", Schema{"Min": Field().Number().NotEqualsField("Max")}, Range{Min: 3, Max: 3}, CodeNotEqualsField},
+		{"times after", Schema{"EndDate": Field().Time().GreaterThanField("StartDate")}, Booking{StartDate: start, EndDate: end}, ""},
+		{"times not after", Schema{"EndDate": Field().Time().GreaterThanField("StartDate")}, Booking{StartDate: end, EndDate: start}, CodeGreaterThanField},
+		{"times not before", Schema{"StartDate": Field().Time().LessThanField("EndDate")}, Booking{StartDate: end, EndDate: end}, CodeLessThanField},
+		{"times equal", Schema{"StartDate": Field().Time().EqualsField("EndDate")}, Booking{StartDate: start, EndDate: start.In(time.Local)}, ""},
+		{"times not different", Schema{"StartDate": Field().Time().NotEqualsField("EndDate")}, Booking{StartDate: start, EndDate: start}, CodeNotEqualsField},
+		{"map documents", Schema{"max": Field().Number().GreaterThanField("min")}, map[string]any{"min": json.Number("5"), "max": 3}, CodeGreaterThanField},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schema.Parse(tt.value)
+			if tt.code == "" {
+				if err != nil {
+					t.Errorf("Parse() should have returned nil, got %v", err)
+				}
+				return
+			}
+
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) || vErr.Code != tt.code {
+				t.Fatalf("expected a %s error, got %v", tt.code, err)
+			}
+			if vErr.Params["field"] == nil {
+				t.Errorf("expected the sibling field in the params, got %v", vErr.Params)
+			}
+		})
+	}
+
+	t.Run("messages name both fields", func(t *testing.T) {
+		err := Schema{"ConfirmPassword": Field().String().EqualsField("Password")}.Parse(Signup{Password: "a", ConfirmPassword: "b"})
+		if err == nil || err.Error() != "ConfirmPassword must be equal to Password" {
+			t.Errorf("unexpected error %v", err)
+		}
+
+		err = Schema{"EndDate": Field().Time().GreaterThanField("StartDate")}.Parse(Booking{StartDate: end, EndDate: start})
+		if err == nil || err.Error() != "EndDate must be after StartDate" {
+			t.Errorf("unexpected error %v", err)
+		}
+	})
+
+	t.Run("invalid sibling", func(t *testing.T) {
+		schemas := map[string]Schema{
+			"missing":         {"Password": Field().String().EqualsField("Email")},
+			"different types": {"Min": Field().Number().EqualsField("Limit")},
+		}
+		values := map[string]any{
+			"missing": Signup{},
+			"different types": struct {
+				Min   int
+				Limit string
+			}{},
+		}
+
+		for name, schema := range schemas {
+			var defErr *SchemaDefinitionError
+			if err := schema.Parse(values[name]); !errors.As(err, &defErr) {
+				t.Errorf("%s: expected a SchemaDefinitionError, got %v", name, err)
+			}
+		}
+	})
+}
@@ -1,3 +1,5 @@
 module github.com/zaniluca/corretto
 
 go 1.22.1
+
+require golang.org/x/text v0.22.0
@@ -0,0 +1,441 @@
+package corretto
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+)
+
+const (
+	jsonSyntaxErrorMsg       = "%v is not valid JSON: %v"
+	jsonTypeErrorMsg         = "%v must be of type %v, got %v"
+	jsonUnknownFieldErrorMsg = "%v is not a known field"
+	jsonTrailingDataMsg      = "invalid character after top-level value"
+)
+
+// decodeWindow is how many bytes of the data read by [ObjectSchema.Decode] are kept to locate the errors
+const decodeWindow = 64 << 10
+
+// Codes of the errors reported while decoding the JSON data, see [ValidationError]
+const (
+	CodeJSONSyntax       = "json.syntax"
+	CodeJSONType         = "json.type"
+	CodeJSONUnknownField = "json.unknown_field"
+)
+
+// DecodeOption configures how the JSON data is decoded by [Schema.Unmarshal] and [Schema.Decode]
+type DecodeOption func(d *json.Decoder)
+
+// DisallowUnknownFields reports a [ValidationError] with code [CodeJSONUnknownField]
+// if the JSON data contains a key that doesn't match any field of the struct, see [json.Decoder.DisallowUnknownFields]
+func DisallowUnknownFields() DecodeOption {
+	return func(d *json.Decoder) {
+		d.DisallowUnknownFields()
+	}
+}
+
+// UseNumber decodes the numbers held by fields of type any as [json.Number] instead of float64, see [json.Decoder.UseNumber]
+func UseNumber() DecodeOption {
+	return func(d *json.Decoder) {
+		d.UseNumber()
+	}
+}
+
+// Unmarshal parses the JSON data into v and validates it based on the schema
+//
+// The value must be a non-nil pointer to a struct, otherwise a [SchemaDefinitionError] is returned.
+// If the data is not valid JSON or it doesn't match the struct, a [ValidationError] is returned
+// with the JSON path of the offending token (e.g. "users[3].address.city")
+//
+//	err := schema.Unmarshal(data, &user, corretto.DisallowUnknownFields())
+func (s Schema) Unmarshal(data []byte, v any, opts ...DecodeOption) error {
+	return s.object().Unmarshal(data, v, opts...)
+}
+
+// Unmarshal behaves the same as [Schema.Unmarshal]
+func (o ObjectSchema) Unmarshal(data []byte, v any, opts ...DecodeOption) error {
+	dec, err := decode(bytes.NewReader(data), v, opts)
+	if err != nil {
+		return o.decodeError(err, data, v)
+	}
+
+	// As for [json.Unmarshal], nothing but white spaces is allowed after the value
+	offset := dec.InputOffset()
+	if _, err := dec.Token(); err != io.EOF {
+		return jsonError(err, data, offset, reflect.TypeOf(v))
+	}
+
+	return o.validate(data, v)
+}
+
+// Decode behaves the same as [Schema.Unmarshal] but it reads the JSON value from r, e.g. the body of a request
+//
+// As [json.Decoder] it may read data from r beyond the JSON value.
+// The errors found after the first 64 KiB of data are reported at the document, since their path is no longer known
+func (s Schema) Decode(r io.Reader, v any, opts ...DecodeOption) error {
+	return s.object().Decode(r, v, opts...)
+}
+
+// Decode behaves the same as [Schema.Decode]
+func (o ObjectSchema) Decode(r io.Reader, v any, opts ...DecodeOption) error {
+	// Unlike the data of Unmarshal the reader can't be read again, so the beginning of it is kept to locate the errors.
+	// A strict schema needs all of it to find the keys it doesn't declare
+	buf := &prefixBuffer{limit: decodeWindow}
+	if o.hasStrict(map[*BaseValidator]bool{}) {
+		buf.limit = math.MaxInt
+	}
+	if _, err := decode(io.TeeReader(r, buf), v, opts); err != nil {
+		if buf.size > int64(len(buf.data)) && (errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)) {
+			// The data ended after the part that was kept
+			return jsonError(err, buf.data, buf.size, reflect.TypeOf(v))
+		}
+		return o.decodeError(err, buf.data, v)
+	}
+
+	return o.validate(buf.data, v)
+}
+
+// prefixBuffer is a writer that keeps the first bytes written to it, up to the limit
+type prefixBuffer struct {
+	data  []byte
+	limit int
+	size  int64 // How many bytes were written, including the ones that weren't kept
+}
+
+func (b *prefixBuffer) Write(p []byte) (int, error) {
+	n := min(len(p), b.limit-len(b.data))
+	b.data = append(b.data, p[:n]...)
+	b.size += int64(len(p))
+	return len(p), nil
+}
+
+// MustUnmarshal behaves the same as [Schema.Unmarshal] but panics if any of the validations fail or if the JSON data cannot be parsed
+func (s Schema) MustUnmarshal(data []byte, v any, opts ...DecodeOption) {
+	s.object().MustUnmarshal(data, v, opts...)
+}
+
+// MustUnmarshal behaves the same as [Schema.MustUnmarshal]
+func (o ObjectSchema) MustUnmarshal(data []byte, v any, opts ...DecodeOption) {
+	err := o.Unmarshal(data, v, opts...)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// decode reads the next JSON value of r into v, the returned decoder can be used to read what follows it
+func decode(r io.Reader, v any, opts []DecodeOption) (*json.Decoder, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return nil, newSchemaDefinitionError("", "JSON data can only be decoded into a non-nil pointer, got %T", v)
+	}
+
+	dec := json.NewDecoder(r)
+	for _, opt := range opts {
+		opt(dec)
+	}
+	return dec, dec.Decode(v)
+}
+
+// decodeError returns the error of decoding the data into v, see [jsonError]
+func (o ObjectSchema) decodeError(err error, data []byte, v any) error {
+	if isSchemaDefinitionError(err) {
+		return o.result(err)
+	}
+	return jsonError(err, data, -1, reflect.TypeOf(v))
+}
+
+// validate parses the value decoded from the data, if the schema (or a nested one) is strict the keys of the data
+// it doesn't declare are reported too (see [Schema.Strict]), before the errors of the fields
+func (o ObjectSchema) validate(data []byte, v any) error {
+	var errs ValidationErrors
+	if o.hasStrict(map[*BaseValidator]bool{}) {
+		errs = o.undeclaredJSONKeys(data, v)
+	}
+
+	err := o.Parse(v)
+	if len(errs) == 0 || isSchemaDefinitionError(err) {
+		return err
+	}
+	if err != nil {
+		errs.add(err)
+	}
+	return errs
+}
+
+// jsonError converts the errors of the decoder into a [ValidationError] with the path of the offending token,
+// the errors not caused by the data (e.g. the ones of the reader) are returned as is
+//
+// The offset is where the error occurred in the data, if it's -1 it's taken from the error itself
+func jsonError(err error, data []byte, offset int64, t reflect.Type) error {
+	var synErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	switch {
+	case errors.As(err, &synErr):
+		if offset < 0 {
+			offset = synErr.Offset
+		}
+		path := jsonPathAt(data, offset)
+		msg := strings.TrimPrefix(synErr.Error(), "json: ")
+		return jsonValidationError(CodeJSONSyntax, nil, path, jsonSyntaxErrorMsg, msg)
+	case errors.As(err, &typeErr):
+		path := jsonPathAt(data, typeErr.Offset)
+		params := map[string]any{"type": typeErr.Type.String(), "value": typeErr.Value}
+		return jsonValidationError(CodeJSONType, params, path, jsonTypeErrorMsg, typeErr.Type, typeErr.Value)
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		if offset < 0 {
+			offset = int64(len(data))
+		}
+		return jsonValidationError(CodeJSONSyntax, nil, jsonPathAt(data, offset), jsonSyntaxErrorMsg, "unexpected end of JSON input")
+	case err == nil:
+		// Unmarshal found another value after the first one
+		return jsonValidationError(CodeJSONSyntax, nil, "", jsonSyntaxErrorMsg, jsonTrailingDataMsg)
+	}
+
+	// The decoder doesn't export the error of unknown fields
+	if key, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		path := strings.Trim(key, `"`)
+		if unknown := unknownJSONFields(data, t); len(unknown) > 0 {
+			path = unknown[0]
+		}
+		return jsonValidationError(CodeJSONUnknownField, nil, path, jsonUnknownFieldErrorMsg)
+	}
+
+	return err
+}
+
+// jsonValidationError creates the [ValidationError] of a decoding error, the field is the last key of the path
+func jsonValidationError(code string, params map[string]any, path string, msg string, args ...any) *ValidationError {
+	name := path
+	if name == "" {
+		name = documentName
+	}
+
+	err := newValidationError(code, params, msg, "", append([]any{name}, args...)...)
+	err.Key = name[strings.LastIndex(name, ".")+1:]
+	err.Field = name
+	err.Path = path
+	return err
+}
+
+// jsonPathAt returns the path of the value the decoder was reading at the offset of the data,
+// e.g. "users[3].address.city". If the offset is beyond the data (see [ObjectSchema.Decode]) the path is not known
+func jsonPathAt(data []byte, offset int64) string {
+	if offset > int64(len(data)) {
+		return ""
+	}
+	w := &jsonWalker{dec: json.NewDecoder(bytes.NewReader(data[:offset])), offset: offset}
+	path, _ := w.walk(nil, reflect.Value{}, nil, "")
+	return path
+}
+
+// unknownJSONFields returns the paths of the keys of the data that don't match any field of the struct they're decoded into
+func unknownJSONFields(data []byte, t reflect.Type) []string {
+	w := &jsonWalker{dec: json.NewDecoder(bytes.NewReader(data)), offset: -1}
+	w.walk(t, reflect.Value{}, nil, "")
+	return w.unknown
+}
+
+// undeclaredJSONKeys returns the errors of the strict schemas (see [Schema.Strict]) for the keys of the data they don't declare,
+// v is the value the data was decoded into
+func (o ObjectSchema) undeclaredJSONKeys(data []byte, v any) ValidationErrors {
+	w := &jsonWalker{dec: json.NewDecoder(bytes.NewReader(data)), offset: -1}
+	w.walk(reflect.TypeOf(v), reflect.ValueOf(v), &BaseValidator{schema: &o}, "")
+	return w.undeclared
+}
+
+// jsonWalker walks the tokens of a JSON document alongside the type it's decoded into and the validator of each value
+type jsonWalker struct {
+	dec        *json.Decoder
+	offset     int64            // The walk stops at the value that ends at the offset, -1 to walk the whole document
+	unknown    []string         // The paths of the keys that don't match any field of the struct they're decoded into
+	undeclared ValidationErrors // The errors of the strict schemas for the keys they don't declare
+}
+
+// walk reads the next value, whose path is provided, and returns the path where the walk stopped (if it did)
+// The type is nil when it's not known (e.g. for the values of a field of type any), the validator when there is none.
+// The value is the one the data was decoded into, if it's known, it selects the alternatives of the unions (see [union])
+func (w *jsonWalker) walk(t reflect.Type, value reflect.Value, v *BaseValidator, path string) (string, bool) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for value.IsValid() && (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) {
+		value = value.Elem()
+	}
+
+	tok, err := w.dec.Token()
+	if err != nil || (w.offset >= 0 && w.dec.InputOffset() >= w.offset) {
+		return path, true
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		var schema *ObjectSchema
+		if v != nil && v.schema != nil {
+			resolved := v.schema.resolve()
+			schema = &resolved
+		}
+		alternatives := w.alternatives(schema, value)
+		var undeclared []string
+		altUndeclared := make([][]string, len(alternatives))
+		for w.dec.More() {
+			tok, err := w.dec.Token()
+			if err != nil {
+				return path, true
+			}
+			key, _ := tok.(string)
+
+			// The key of the schema is the name of the struct field or the key of the map document
+			var elem reflect.Type
+			var elemValue reflect.Value
+			name := key
+			if t != nil && t.Kind() == reflect.Struct {
+				f, ok := jsonField(t, key)
+				if !ok {
+					w.unknown = append(w.unknown, joinPath(path, key))
+				} else if value.Kind() == reflect.Struct {
+					elemValue, _ = value.FieldByIndexErr(f.Index)
+				}
+				elem, name = f.Type, f.Name
+			} else if t != nil && t.Kind() == reflect.Map {
+				elem = t.Elem()
+			}
+			if value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String {
+				elemValue = value.MapIndex(reflect.ValueOf(key).Convert(value.Type().Key()))
+			}
+
+			var elemValidator *BaseValidator
+			if schema != nil {
+				if ev, ok := schema.schema[name]; ok {
+					elemValidator = ev.getBaseValidator()
+				} else {
+					undeclared = append(undeclared, key)
+				}
+				// The keys the schema doesn't declare may be declared by the alternative of its union
+				for i, alt := range alternatives {
+					ev, ok := alt.schema[name]
+					switch {
+					case !ok:
+						altUndeclared[i] = append(altUndeclared[i], key)
+					case elemValidator == nil:
+						elemValidator = ev.getBaseValidator()
+					}
+				}
+			} else if v != nil && v.elements != nil {
+				elemValidator = v.elements.getBaseValidator()
+			}
+
+			if p, stopped := w.walk(elem, elemValue, elemValidator, joinPath(path, key)); stopped {
+				return p, true
+			}
+		}
+
+		// The keys of the objects decoded into map documents are checked when parsing them, see [ObjectSchema.checkUnknownKeys]
+		if t != nil && t.Kind() == reflect.Struct {
+			if schema != nil && schema.options.unknownKeys == strictUnknownKeys {
+				w.addUndeclared(*schema, undeclared, path)
+			}
+			for i, alt := range alternatives {
+				if alt.options.unknownKeys == strictUnknownKeys {
+					w.addUndeclared(alt, altUndeclared[i], path)
+				}
+			}
+		}
+	case json.Delim('['):
+		var elem reflect.Type
+		if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+			elem = t.Elem()
+		}
+		var elemValidator *BaseValidator
+		if v != nil && v.elements != nil {
+			elemValidator = v.elements.getBaseValidator()
+		}
+		for i := 0; w.dec.More(); i++ {
+			var elemValue reflect.Value
+			if (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) && i < value.Len() {
+				elemValue = value.Index(i)
+			}
+			if p, stopped := w.walk(elem, elemValue, elemValidator, fmt.Sprintf(arrayElementPath, path, i)); stopped {
+				return p, true
+			}
+		}
+	default:
+		return "", false
+	}
+
+	// Closing delimiter
+	if _, err := w.dec.Token(); err != nil {
+		return path, true
+	}
+	return "", false
+}
+
+// addUndeclared adds the error of the strict schema for the keys it doesn't declare of the object at the path, if any
+func (w *jsonWalker) addUndeclared(schema ObjectSchema, undeclared []string, path string) {
+	name := path
+	if name == "" {
+		name = documentName
+	}
+	parent := &evalContext{key: name[strings.LastIndex(name, ".")+1:], fieldName: name, path: path}
+	if err := schema.unknownKeysError(undeclared, parent); err != nil {
+		w.undeclared.add(err)
+	}
+}
+
+// alternatives returns the alternatives of the unions of the schema that apply to the value, resolved (see [Lazy])
+func (w *jsonWalker) alternatives(schema *ObjectSchema, value reflect.Value) []ObjectSchema {
+	if schema == nil || !value.IsValid() || !value.CanInterface() {
+		return nil
+	}
+
+	var alternatives []ObjectSchema
+	for _, u := range schema.options.unions {
+		if alt, ok := u.selected(value.Interface()); ok {
+			alternatives = append(alternatives, alt.resolve())
+		}
+	}
+	return alternatives
+}
+
+// jsonField returns the field of the struct t the JSON key is decoded into,
+// matching the field names (or the names in the json tags) as [json.Unmarshal] does
+func jsonField(t reflect.Type, key string) (reflect.StructField, bool) {
+	var folded *reflect.StructField
+	for _, f := range reflect.VisibleFields(t) {
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			// The fields of embedded structs are promoted
+			if f.Anonymous && indirect(f.Type).Kind() == reflect.Struct {
+				continue
+			}
+			name = f.Name
+		}
+
+		if name == key {
+			return f, true
+		}
+		if folded == nil && strings.EqualFold(name, key) {
+			folded = &f
+		}
+	}
+	if folded == nil {
+		return reflect.StructField{}, false
+	}
+	return *folded, true
+}
+
+// indirect returns the type pointed by t, dereferencing it until it's not a pointer
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,223 @@
+package corretto
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name        string
+		bytes       []byte
+		dst         any
+		expectError bool
+	}{
+		{
+			name:  "valid json and valid data",
+			bytes: []byte(`{"Name": "John", "Age": 30}`),
+			dst: &struct {
+				Name string
+				Age  int
+			}{},
+			expectError: false,
+		},
+		{
+			name:  "valid json and invalid data",
+			bytes: []byte(`{"Name": "John", "Age": 12}`),
+			dst: &struct {
+				Name string
+				Age  int
+			}{},
+			expectError: true,
+		},
+		{
+			name:  "invalid json",
+			bytes: []byte(`{"Name": "John", "Age": `),
+			dst: &struct {
+				Name string
+				Age  int
+			}{},
+			expectError: true,
+		},
+	}
+
+	schema := Schema{
+		"Name": Field().String().NonEmpty(),
+		"Age":  Field().Number().Min(18),
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Unmarshal(tt.bytes, tt.dst)
+			if tt.expectError && err == nil {
+				t.Errorf("Unmarshal() should have returned an error")
+			}
+		})
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	type Address struct {
+		City string `json:"city"`
+	}
+
+	type User struct {
+		Name      string    `json:"name"`
+		Age       int       `json:"age"`
+		Addresses []Address `json:"addresses"`
+		Extra     any       `json:"extra"`
+	}
+
+	schema := Schema{
+		"Name": Field().String().NonEmpty(),
+		"Age":  Field().Number().Min(18),
+	}
+
+	tests := []struct {
+		name         string
+		data         string
+		opts         []DecodeOption
+		expectedCode string
+		expectedPath string
+	}{
+		{"syntax error", `{"name": "John", "age": }`, nil, CodeJSONSyntax, "age"},
+		{"nested syntax error", `{"name": "John", "addresses": [{"city": "Rome"}, {"city": tru}]}`, nil, CodeJSONSyntax, "addresses[1].city"},
+		{"unexpected end", `{"name": "John", "addresses": [{"city": "Ro`, nil, CodeJSONSyntax, "addresses[0].city"},
+		{"empty data", ``, nil, CodeJSONSyntax, ""},
+		{"trailing data", `{"name": "John", "age": 30} {}`, nil, CodeJSONSyntax, ""},
+		{"trailing garbage", `{"name": "John", "age": 30} x`, nil, CodeJSONSyntax, ""},
+		{"type error", `{"name": "John", "age": "30"}`, nil, CodeJSONType, "age"},
+		{"nested type error", `{"name": "John", "age": 30, "addresses": [{"city": "Rome"}, {"city": 3}]}`, nil, CodeJSONType, "addresses[1].city"},
+		{"object type error", `{"name": {"first": "John"}, "age": 30}`, nil, CodeJSONType, "name"},
+		{"unknown field", `{"name": "John", "addresses": [{"city": "Rome", "zip": "00100"}]}`, []DecodeOption{DisallowUnknownFields()}, CodeJSONUnknownField, "addresses[0].zip"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &User{}
+			err := schema.Unmarshal([]byte(tt.data), u, tt.opts...)
+
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) {
+				t.Fatalf("expected a ValidationError, got %v", err)
+			}
+			if vErr.Code != tt.expectedCode {
+				t.Errorf("expected code %s, got %s (%v)", tt.expectedCode, vErr.Code, err)
+			}
+			if vErr.Path != tt.expectedPath {
+				t.Errorf("expected path %q, got %q (%v)", tt.expectedPath, vErr.Path, err)
+			}
+		})
+	}
+
+	t.Run("type error message", func(t *testing.T) {
+		err := schema.Unmarshal([]byte(`{"age": "30"}`), &User{})
+		if err == nil || err.Error() != "age must be of type int, got string" {
+			t.Errorf("unexpected error %v", err)
+		}
+	})
+
+	t.Run("unknown fields are allowed by default", func(t *testing.T) {
+		if err := schema.Unmarshal([]byte(`{"name": "John", "age": 30, "zip": "00100"}`), &User{}); err != nil {
+			t.Errorf("Unmarshal() should have returned nil, got %v", err)
+		}
+	})
+
+	t.Run("requires a pointer", func(t *testing.T) {
+		var defErr *SchemaDefinitionError
+		if err := schema.Unmarshal([]byte(`{"name": "John", "age": 30}`), User{}); !errors.As(err, &defErr) {
+			t.Errorf("expected a SchemaDefinitionError, got %v", err)
+		}
+		if err := schema.Unmarshal([]byte(`{"name": "John", "age": 30}`), (*User)(nil)); !errors.As(err, &defErr) {
+			t.Errorf("expected a SchemaDefinitionError, got %v", err)
+		}
+	})
+
+	t.Run("use number", func(t *testing.T) {
+		u := &User{}
+		if err := schema.Unmarshal([]byte(`{"name": "John", "age": 30, "extra": 1.50}`), u, UseNumber()); err != nil {
+			t.Fatalf("Unmarshal() should have returned nil, got %v", err)
+		}
+		if n, ok := u.Extra.(json.Number); !ok || n.String() != "1.50" {
+			t.Errorf("expected a json.Number, got %T %v", u.Extra, u.Extra)
+		}
+	})
+}
+
+func TestDecode(t *testing.T) {
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	schema := Schema{
+		"Name": Field().String().NonEmpty(),
+		"Age":  Field().Number().Min(18),
+	}
+
+	t.Run("decodes and validates", func(t *testing.T) {
+		u := &User{}
+		if err := schema.Decode(strings.NewReader(`{"Name": "John", "Age": 30}`), u); err != nil {
+			t.Fatalf("Decode() should have returned nil, got %v", err)
+		}
+		if u.Name != "John" || u.Age != 30 {
+			t.Errorf("Decode() should have filled the struct, got %+v", u)
+		}
+
+		var vErr *ValidationError
+		if err := schema.Decode(strings.NewReader(`{"Name": "John", "Age": 12}`), u); !errors.As(err, &vErr) || vErr.Code != CodeNumberMin {
+			t.Errorf("expected a %s error, got %v", CodeNumberMin, err)
+		}
+	})
+
+	t.Run("reports decoding errors", func(t *testing.T) {
+		var vErr *ValidationError
+		err := schema.Decode(strings.NewReader(`{"Name": "John", "Age": true}`), &User{})
+		if !errors.As(err, &vErr) || vErr.Code != CodeJSONType || vErr.Path != "Age" {
+			t.Errorf("expected a %s error for Age, got %v", CodeJSONType, err)
+		}
+	})
+
+	t.Run("reports the errors after the kept data at the document", func(t *testing.T) {
+		name := strings.Repeat("a", decodeWindow)
+		cases := []struct {
+			name string
+			data string
+			code string
+			path string
+		}{
+			{"within the kept data", `{"Age": true, "Name": "` + name + `"}`, CodeJSONType, "Age"},
+			{"type error", `{"Name": "` + name + `", "Age": true}`, CodeJSONType, ""},
+			{"unexpected end", `{"Name": "` + name + `", "Age": `, CodeJSONSyntax, ""},
+		}
+
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				var vErr *ValidationError
+				err := schema.Decode(strings.NewReader(tc.data), &User{})
+				if !errors.As(err, &vErr) || vErr.Code != tc.code || vErr.Path != tc.path {
+					t.Errorf("expected a %s error at %q, got %v", tc.code, tc.path, err)
+				}
+			})
+		}
+	})
+
+	t.Run("returns the errors of the reader", func(t *testing.T) {
+		readErr := errors.New("connection reset")
+		err := schema.Decode(&failingReader{err: readErr}, &User{})
+		if !errors.Is(err, readErr) {
+			t.Errorf("expected the error of the reader, got %v", err)
+		}
+	})
+}
+
+// failingReader returns the error on every read
+type failingReader struct {
+	err error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,142 @@
+package corretto
+
+import (
+	"reflect"
+	"sync"
+)
+
+const maxDepthErrorMsg = "%v exceeds the maximum depth of %v"
+
+// CodeMaxDepth is the [ValidationError.Code] reported when a value nests more lazy schemas than allowed, see [ObjectSchema.MaxDepth]
+const CodeMaxDepth = "schema.max_depth"
+
+// DefaultMaxDepth is the maximum number of nested lazy schemas (see [Lazy]) a value can have, unless [ObjectSchema.MaxDepth] is used
+const DefaultMaxDepth = 100
+
+// Lazy returns a schema that validates the value with the schema returned by f, which is called only once when the schema is first used.
+// It allows a schema to reference itself, e.g. to validate trees
+//
+//	var categorySchema corretto.Schema
+//	categorySchema = corretto.Schema{
+//		"Name":     corretto.Field().String().NonEmpty(),
+//		"Children": corretto.Field().Array().Of(corretto.Field().Schema(corretto.Lazy(func() corretto.Schema {
+//			return categorySchema
+//		}))),
+//	}
+//
+// Values nested in more than [DefaultMaxDepth] lazy schemas are reported with an error of code [CodeMaxDepth], see [ObjectSchema.MaxDepth].
+// A value that references itself through pointers (or a map document that contains itself) is validated only once,
+// when the schema meets it again while validating it, the nested occurrence is skipped.
+//
+// The returned schema can't be composed (e.g. with [Schema.Extend]), compose the schema returned by f instead
+func Lazy[S Shape](f func() S) ObjectSchema {
+	return ObjectSchema{options: schemaOptions{lazy: &lazySchema{f: func() ObjectSchema {
+		return f().object()
+	}}}}
+}
+
+// MaxDepth returns a copy of the lazy schema (see [Lazy]) that reports an error with code [CodeMaxDepth]
+// if the value is nested in more than max lazy schemas, it has no effect on other schemas
+//
+//	corretto.Lazy(func() corretto.Schema { return commentSchema }).MaxDepth(10)
+func (o ObjectSchema) MaxDepth(max int) ObjectSchema {
+	o.options.maxDepth = max
+	return o
+}
+
+// lazySchema holds the function of a [Lazy] schema and the schema it returns
+type lazySchema struct {
+	f      func() ObjectSchema
+	once   sync.Once
+	schema ObjectSchema
+
+	mu        sync.Mutex
+	compiling map[reflect.Type]bool // The types the schema is being compiled for, see [lazySchema.compile]
+}
+
+// resolve returns the schema returned by the function, calling it the first time
+func (l *lazySchema) resolve() ObjectSchema {
+	l.once.Do(func() {
+		l.schema = l.f()
+	})
+	return l.schema
+}
+
+// compile checks the resolved schema against the struct type
+// A recursive type is checked only once, when the schema meets it again while compiling it the nested occurrence is accepted
+func (l *lazySchema) compile(t reflect.Type, path string) error {
+	l.mu.Lock()
+	if l.compiling[t] {
+		l.mu.Unlock()
+		return nil
+	}
+	if l.compiling == nil {
+		l.compiling = make(map[reflect.Type]bool)
+	}
+	l.compiling[t] = true
+	l.mu.Unlock()
+
+	defer func() {
+		l.mu.Lock()
+		delete(l.compiling, t)
+		l.mu.Unlock()
+	}()
+	return l.resolve().compile(t, path)
+}
+
+// resolve returns the schema a lazy schema resolves to, other schemas are returned as they are
+func (o ObjectSchema) resolve() ObjectSchema {
+	if o.options.lazy != nil {
+		return o.options.lazy.resolve()
+	}
+	return o
+}
+
+// visit identifies a value validated by a lazy schema, see [identityOf]
+type visit struct {
+	ptr uintptr
+	t   reflect.Type
+}
+
+// identityOf returns the identity of the value pointed by v (or of v itself if it's addressable),
+// values that can't reference themselves have none
+func identityOf(v reflect.Value) (visit, bool) {
+	switch {
+	case v.Kind() == reflect.Ptr && !v.IsNil():
+		return visit{v.Pointer(), v.Type().Elem()}, true
+	case v.Kind() == reflect.Map && !v.IsNil():
+		return visit{v.Pointer(), v.Type()}, true
+	case v.CanAddr():
+		return visit{v.UnsafeAddr(), v.Type()}, true
+	}
+	return visit{}, false
+}
+
+// parseLazy runs parse with the schema the lazy schema resolves to, value is the one being validated
+// It stops the values nested too deeply and skips the ones that are already being validated by an outer lazy schema
+func (o ObjectSchema) parseLazy(value reflect.Value, parent *evalContext, parse func(inner ObjectSchema, parent *evalContext) error) error {
+	maxDepth := o.options.maxDepth
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	if parent.depth >= maxDepth {
+		err := newValidationError(CodeMaxDepth, map[string]any{"max": maxDepth}, maxDepthErrorMsg, "", parent.name(), maxDepth)
+		return parent.schemaError(err)
+	}
+
+	id, ok := identityOf(value)
+	if ok && parent.visiting[id] {
+		return nil
+	}
+
+	e := *parent
+	e.depth++
+	if ok {
+		if e.visiting == nil {
+			e.visiting = make(map[visit]bool)
+		}
+		e.visiting[id] = true
+		defer delete(e.visiting, id)
+	}
+	return parse(o.options.lazy.resolve(), &e)
+}
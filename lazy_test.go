@@ -0,0 +1,157 @@
+package corretto
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type category struct {
+	Name     string
+	Children []category
+}
+
+type node struct {
+	Value int
+	Next  *node
+}
+
+func TestLazy(t *testing.T) {
+	var categorySchema Schema
+	categorySchema = Schema{
+		"Name": Field().String().NonEmpty(),
+		"Children": Field().Array().Of(Field().Schema(Lazy(func() Schema {
+			return categorySchema
+		}))),
+	}
+
+	t.Run("validates trees", func(t *testing.T) {
+		tree := category{Name: "root", Children: []category{
+			{Name: "a", Children: []category{{Name: "a1"}}},
+			{Name: "b"},
+		}}
+		if err := categorySchema.Parse(tree); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+
+		tree.Children[0].Children = append(tree.Children[0].Children, category{})
+		var vErr *ValidationError
+		err := categorySchema.Parse(tree)
+		if !errors.As(err, &vErr) || vErr.Path != "Children[0].Children[1].Name" {
+			t.Errorf("expected an error for Children[0].Children[1].Name, got %v", err)
+		}
+	})
+
+	t.Run("at the root", func(t *testing.T) {
+		root := Lazy(func() Schema { return categorySchema })
+		if err := root.Parse(&category{Name: "root", Children: []category{{}}}); err == nil {
+			t.Errorf("Parse() should have returned an error")
+		}
+	})
+
+	t.Run("compiles recursive types", func(t *testing.T) {
+		compiled, err := categorySchema.Compile(reflect.TypeFor[category]())
+		if err != nil {
+			t.Fatalf("Compile() should have returned nil, got %v", err)
+		}
+		if err := compiled.Parse(category{Name: "root", Children: []category{{Name: "a"}}}); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+
+		var defErr *SchemaDefinitionError
+		_, err = categorySchema.Compile(reflect.TypeFor[struct {
+			Name     string
+			Children []int
+		}]())
+		if !errors.As(err, &defErr) {
+			t.Errorf("expected a SchemaDefinitionError, got %v", err)
+		}
+	})
+
+	t.Run("map documents", func(t *testing.T) {
+		doc := map[string]any{
+			"Name":     "root",
+			"Children": []any{map[string]any{"Name": "", "Children": []any{}}},
+		}
+
+		var vErr *ValidationError
+		err := categorySchema.Parse(doc)
+		if !errors.As(err, &vErr) || vErr.Path != "Children[0].Name" {
+			t.Errorf("expected an error for Children[0].Name, got %v", err)
+		}
+	})
+}
+
+func TestLazyLimits(t *testing.T) {
+	var nodeSchema Schema
+	lazyNode := Field().Optional().Schema(Lazy(func() Schema { return nodeSchema }).MaxDepth(3))
+	nodeSchema = Schema{
+		"Value": Field().Number().Positive(),
+		"Next":  lazyNode,
+	}
+
+	list := func(n int) *node {
+		var head *node
+		for i := 0; i < n; i++ {
+			head = &node{Value: 1, Next: head}
+		}
+		return head
+	}
+
+	t.Run("within the max depth", func(t *testing.T) {
+		if err := nodeSchema.Parse(list(4)); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+	})
+
+	t.Run("beyond the max depth", func(t *testing.T) {
+		var vErr *ValidationError
+		err := nodeSchema.Parse(list(5))
+		if !errors.As(err, &vErr) || vErr.Code != CodeMaxDepth || vErr.Path != "Next.Next.Next.Next" {
+			t.Fatalf("expected a %s error for Next.Next.Next.Next, got %v", CodeMaxDepth, err)
+		}
+		if vErr.Params["max"] != 3 {
+			t.Errorf("expected the max depth in the params, got %v", vErr.Params)
+		}
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		var cycleSchema Schema
+		cycleSchema = Schema{
+			"Value": Field().Number().Positive(),
+			"Next":  Field().Optional().Schema(Lazy(func() Schema { return cycleSchema })),
+		}
+
+		a := &node{Value: 1}
+		b := &node{Value: 2, Next: a}
+		a.Next = b
+		if err := cycleSchema.Parse(a); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+
+		b.Value = -1
+		var vErr *ValidationError
+		if err := cycleSchema.ParseAll(a); !errors.As(err, &vErr) || vErr.Path != "Next.Value" {
+			t.Errorf("expected an error for Next.Value, got %v", err)
+		}
+
+		self := &node{Value: 1}
+		self.Next = self
+		if err := cycleSchema.Parse(self); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+	})
+
+	t.Run("cyclic map documents", func(t *testing.T) {
+		var docSchema Schema
+		docSchema = Schema{
+			"next": Field().Optional().Schema(Lazy(func() Schema { return docSchema })),
+		}
+
+		doc := map[string]any{}
+		doc["next"] = doc
+		if err := docSchema.Parse(doc); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+	})
+}
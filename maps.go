@@ -0,0 +1,213 @@
+package corretto
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+const (
+	notAMapErrorMsg         = "%v is not a map"
+	emptyMapErrorMsg        = "%v cannot be empty"
+	mapMinSizeErrorMsg      = "%v must have at least %v entries"
+	mapMaxSizeErrorMsg      = "%v must have at most %v entries"
+	mapRequiredKeysErrorMsg = "%v is missing the keys %v"
+)
+
+// Codes of the map validations, see [ValidationError]
+const (
+	CodeNotAMap         = "map.type"
+	CodeMapNonEmpty     = "map.nonempty"
+	CodeMapMinSize      = "map.min_size"
+	CodeMapMaxSize      = "map.max_size"
+	CodeMapRequiredKeys = "map.required_keys"
+)
+
+const (
+	mapKeysFieldName   = "%v's keys"
+	mapValuesFieldName = "%v's values"
+	mapStringEntryPath = "%v[%q]"
+	mapEntryPath       = "%v[%v]"
+)
+
+type MapValidator struct {
+	*BaseValidator
+}
+
+// Map checks if the field is a map
+//
+// It doesn't check if the map is empty, use [MapValidator.NonEmpty] to check for empty maps
+func (v *BaseValidator) Map(msg ...string) *MapValidator {
+	cmsg := optional(msg)
+
+	v.typeChecks = append(v.typeChecks, kindCheck("Map()", reflect.Map))
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Kind() != reflect.Map {
+			return abort(newValidationError(CodeNotAMap, nil, notAMapErrorMsg, cmsg, e.fieldName))
+		}
+		return nil
+	})
+
+	return &MapValidator{v}
+}
+
+// NonEmpty checks if the map has at least one entry
+func (v *MapValidator) NonEmpty(msg ...string) *MapValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Len() == 0 {
+			return newValidationError(CodeMapNonEmpty, nil, emptyMapErrorMsg, cmsg, e.fieldName)
+		}
+		return nil
+	})
+	return v
+}
+
+// MinSize checks if the map has a number of entries greater than or equal to the provided value
+func (v *MapValidator) MinSize(min int, msg ...string) *MapValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Len() < min {
+			return newValidationError(CodeMapMinSize, map[string]any{"min": min}, mapMinSizeErrorMsg, cmsg, e.fieldName, min)
+		}
+		return nil
+	})
+	return v
+}
+
+// MaxSize checks if the map has a number of entries less than or equal to the provided value
+func (v *MapValidator) MaxSize(max int, msg ...string) *MapValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Len() > max {
+			return newValidationError(CodeMapMaxSize, map[string]any{"max": max}, mapMaxSizeErrorMsg, cmsg, e.fieldName, max)
+		}
+		return nil
+	})
+	return v
+}
+
+// RequiredKeys checks if the map contains all the provided keys, it can only be used with maps that have string keys
+func (v *MapValidator) RequiredKeys(keys []string, msg ...string) *MapValidator {
+	cmsg := optional(msg)
+
+	v.typeChecks = append(v.typeChecks, func(t reflect.Type, path string) error {
+		if t.Kind() == reflect.Map && t.Key().Kind() != reflect.String {
+			return newSchemaDefinitionError(path, "RequiredKeys() can't be used on field %s of type %v, keys must be strings", path, t)
+		}
+		return nil
+	})
+	v.validations = append(v.validations, func(e *evalContext) error {
+		keyType := e.field.Type().Key()
+		if keyType.Kind() != reflect.String {
+			return newSchemaDefinitionError(e.path, "RequiredKeys() can't be used on field %s of type %v, keys must be strings", e.path, e.field.Type())
+		}
+
+		var missing []string
+		for _, key := range keys {
+			if !e.field.MapIndex(reflect.ValueOf(key).Convert(keyType)).IsValid() {
+				missing = append(missing, key)
+			}
+		}
+		if len(missing) > 0 {
+			return newValidationError(CodeMapRequiredKeys, map[string]any{"keys": missing}, mapRequiredKeysErrorMsg, cmsg, e.fieldName, missing)
+		}
+		return nil
+	})
+	return v
+}
+
+// Keys checks if all the keys of the map are valid according to the provided validator
+//
+//	"Labels": corretto.Field().Map().Keys(corretto.Field().String().MaxLength(63)),
+func (v *MapValidator) Keys(validator validator) *MapValidator {
+	v.typeChecks = append(v.typeChecks, func(t reflect.Type, path string) error {
+		if t.Kind() != reflect.Map {
+			return nil
+		}
+		return validator.getBaseValidator().compile(t.Key(), fmt.Sprintf(arrayElementsPath, path))
+	})
+	v.validations = append(v.validations, func(e *evalContext) error {
+		return eachMapEntry(e, validator, mapKeysFieldName, false, func(key reflect.Value) reflect.Value {
+			return key
+		})
+	})
+	return v
+}
+
+// Values checks if all the values of the map are valid according to the provided validator,
+// it works the same as [ArrayValidator.Of] does for arrays
+//
+//	"Labels": corretto.Field().Map().Values(corretto.Field().String().NonEmpty()),
+//
+// Errors are reported with the path of the entry, e.g. `Labels["env"]`
+func (v *MapValidator) Values(validator validator) *MapValidator {
+	v.elements = validator
+	v.typeChecks = append(v.typeChecks, func(t reflect.Type, path string) error {
+		// The values of map[string]any are checked only when parsing
+		if t.Kind() != reflect.Map || t.Elem().Kind() == reflect.Interface {
+			return nil
+		}
+		return validator.getBaseValidator().compile(t.Elem(), fmt.Sprintf(arrayElementsPath, path))
+	})
+	v.validations = append(v.validations, func(e *evalContext) error {
+		return eachMapEntry(e, validator, mapValuesFieldName, true, func(key reflect.Value) reflect.Value {
+			return e.field.MapIndex(key)
+		})
+	})
+	return v
+}
+
+// Test allows you to run a custom validation function on the map
+//
+// The function should have the signature:
+//
+//	func (ctx corretto.Context, value reflect.Value) error
+//
+// The function will receive the context and the map as a [reflect.Value]
+func (v *MapValidator) Test(f CustomValidationFunc[reflect.Value]) *MapValidator {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		return customError(f(e.ctx, e.field))
+	})
+	return v
+}
+
+// eachMapEntry runs the validator on the value selected from each key of the map, in the order of the sorted keys
+// If values is true the selected values are the ones of the map, so that transformations can replace them
+func eachMapEntry(e *evalContext, validator validator, name string, values bool, selector func(key reflect.Value) reflect.Value) error {
+	var errs ValidationErrors
+	bv := validator.getBaseValidator()
+	for _, key := range sortedKeys(e.field) {
+		path := fmt.Sprintf(mapEntryPath, e.path, valueOf(key))
+		if key.Kind() == reflect.String {
+			path = fmt.Sprintf(mapStringEntryPath, e.path, key.String())
+		}
+
+		el := e.element(bv, selector(key), path, fmt.Sprintf(name, e.fieldName))
+		if values {
+			el.entry = mapEntry{e.field, key}
+		}
+		if err := bv.check(el); err != nil {
+			// If any of the entries fail the validation, return the error
+			if !e.all || isSchemaDefinitionError(err) {
+				return err
+			}
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
+// sortedKeys returns the keys of the map sorted by their string representation,
+// so that the entries are always validated in the same order
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		return strings.Compare(fmt.Sprint(valueOf(a)), fmt.Sprint(valueOf(b)))
+	})
+	return keys
+}
@@ -0,0 +1,152 @@
+package corretto
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	schema := Schema{
+		"mapField": Field().Map(),
+	}
+
+	tests := []struct {
+		name        string
+		mapField    any
+		expectError bool
+	}{
+		{"empty map", map[string]int{}, false},
+		{"zero value non map", 0, true},
+		{"valid map", map[string]int{"a": 1}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := schema.Parse(&struct{ mapField any }{mapField: tc.mapField})
+			if tc.expectError && err == nil {
+				t.Errorf("Parse() should have returned an error")
+			}
+		})
+	}
+}
+
+func TestMapSize(t *testing.T) {
+	tests := []struct {
+		name        string
+		validator   validator
+		mapField    map[string]int
+		expectError bool
+	}{
+		{"non empty with empty map", Field().Map().NonEmpty(), map[string]int{}, true},
+		{"non empty with entries", Field().Map().NonEmpty(), map[string]int{"a": 1}, false},
+		{"min size with less entries", Field().Map().MinSize(2), map[string]int{"a": 1}, true},
+		{"min size with enough entries", Field().Map().MinSize(2), map[string]int{"a": 1, "b": 2}, false},
+		{"max size with more entries", Field().Map().MaxSize(1), map[string]int{"a": 1, "b": 2}, true},
+		{"max size with enough entries", Field().Map().MaxSize(1), map[string]int{"a": 1}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Schema{"mapField": tc.validator}.Parse(&struct{ mapField map[string]int }{mapField: tc.mapField})
+			if tc.expectError && err == nil {
+				t.Errorf("Parse() should have returned an error")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("Parse() should have returned nil, got %v", err)
+			}
+		})
+	}
+}
+
+func TestMapRequiredKeys(t *testing.T) {
+	type labels map[string]string
+
+	schema := Schema{
+		"Labels": Field().Map().RequiredKeys([]string{"env", "team"}),
+	}
+
+	err := schema.Parse(struct{ Labels labels }{Labels: labels{"env": "prod"}})
+
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) || vErr.Code != CodeMapRequiredKeys {
+		t.Fatalf("Parse() should have returned a required keys error, got %v", err)
+	}
+	if vErr.Message != "Labels is missing the keys [team]" {
+		t.Errorf("unexpected message %s", vErr.Message)
+	}
+
+	if err := schema.Parse(struct{ Labels labels }{Labels: labels{"env": "prod", "team": "a"}}); err != nil {
+		t.Errorf("Parse() should have returned nil, got %v", err)
+	}
+
+	var defErr *SchemaDefinitionError
+	err = schema.Parse(struct{ Labels map[int]string }{Labels: map[int]string{}})
+	if !errors.As(err, &defErr) {
+		t.Errorf("Parse() should have returned a SchemaDefinitionError for non string keys, got %v", err)
+	}
+}
+
+func TestMapKeysAndValues(t *testing.T) {
+	type Server struct {
+		Labels map[string]string
+	}
+
+	schema := Schema{
+		"Labels": Field().Map().
+			Keys(Field().String().MaxLength(5)).
+			Values(Field().String().NonEmpty()),
+	}
+
+	err := schema.ParseAll(Server{Labels: map[string]string{"env": "", "region": "eu", "team": ""}})
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("ParseAll() should have returned ValidationErrors, got %v", err)
+	}
+
+	expected := []string{`Labels["region"]`, `Labels["env"]`, `Labels["team"]`}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), errs)
+	}
+	for i, path := range expected {
+		if vErr := errs[i].(*ValidationError); vErr.Path != path {
+			t.Errorf("expected path %s, got %s", path, vErr.Path)
+		}
+	}
+
+	t.Run("uses the map name in the messages", func(t *testing.T) {
+		if errs[1].Error() != "Labels's values cannot be empty" {
+			t.Errorf("unexpected message %s", errs[1].Error())
+		}
+	})
+
+	t.Run("non string keys", func(t *testing.T) {
+		s := Schema{"Codes": Field().Map().Values(Field().Number().Min(10))}
+
+		var vErr *ValidationError
+		err := s.Parse(struct{ Codes map[int]int }{Codes: map[int]int{1: 20, 2: 5}})
+		if !errors.As(err, &vErr) || vErr.Path != "Codes[2]" {
+			t.Errorf("Parse() should have returned an error for Codes[2], got %v", err)
+		}
+	})
+}
+
+func TestMapCustomValidation(t *testing.T) {
+	schema := Schema{
+		"Labels": Field().Map().Test(func(ctx Context, value reflect.Value) error {
+			if value.Len()%2 != 0 {
+				return fmt.Errorf("Labels must have an even number of entries")
+			}
+			return nil
+		}),
+	}
+
+	if err := schema.Parse(struct{ Labels map[string]string }{Labels: map[string]string{"a": "1"}}); err == nil {
+		t.Errorf("Parse() should have returned an error")
+	}
+	if err := schema.Parse(struct{ Labels map[string]string }{Labels: map[string]string{}}); err != nil {
+		t.Errorf("Parse() should have returned nil, got %v", err)
+	}
+}
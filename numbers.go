@@ -1,13 +1,16 @@
 package corretto
 
 import (
+	"encoding/json"
+	"fmt"
 	"math"
 	"reflect"
 	"slices"
+	"strconv"
 )
 
 type Number interface {
-	int | int8 | int16 | int32 | int64 | float32 | float64
+	int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | uintptr | float32 | float64
 }
 
 const (
@@ -21,20 +24,67 @@ const (
 	zeroNumberErrorMsg       = "%v is required"
 	minNumberErrorMsg        = "%v must be at least %v"
 	maxNumberErrorMsg        = "%v must be less than %v"
+	outOfRangeNumberMsg      = "%v is out of range"
+	greaterThanNumberMsg     = "%v must be greater than %v"
+	lessThanNumberMsg        = "%v must be less than %v"
+	betweenNumberMsg         = "%v must be in the range %v"
+)
+
+// Codes of the number validations, see [ValidationError]
+const (
+	CodeNotANumber        = "number.type"
+	CodeNumberNonZero     = "number.non_zero"
+	CodeNumberPositive    = "number.positive"
+	CodeNumberNegative    = "number.negative"
+	CodeNumberNonNegative = "number.non_negative"
+	CodeNumberNonPositive = "number.non_positive"
+	CodeNumberMin         = "number.min"
+	CodeNumberMax         = "number.max"
+	CodeNumberOneOf       = "number.one_of"
+	CodeNumberMultipleOf  = "number.multiple_of"
+	CodeNumberFinite      = "number.finite"
+	CodeNumberOutOfRange  = "number.out_of_range"
+	CodeNumberGreaterThan = "number.greater_than"
+	CodeNumberLessThan    = "number.less_than"
+	CodeNumberBetween     = "number.between"
+)
+
+// The kinds accepted by [BaseValidator.Number]
+var (
+	intKinds    = []reflect.Kind{reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64}
+	uintKinds   = []reflect.Kind{reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr}
+	floatKinds  = []reflect.Kind{reflect.Float32, reflect.Float64}
+	numberKinds = slices.Concat(intKinds, uintKinds, floatKinds)
+
+	jsonNumberType = reflect.TypeFor[json.Number]()
 )
 
 type NumberValidator struct {
 	*BaseValidator
 }
 
-// Number checks if the field is a number, either an integer or a float
+// Number checks if the field is a number, either a signed or unsigned integer or a float
+//
+// A [json.Number] (see [UseNumber]) is converted to an int64 or a float64 before running the following validations
 func (v *BaseValidator) Number(msg ...string) *NumberValidator {
 	cmsg := optional(msg)
-	numbers := []reflect.Kind{reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64}
 
-	v.validations = append(v.validations, func() error {
-		if !slices.Contains(numbers, v.field.Kind()) {
-			return newValidationError(notANumberMsg, cmsg, v.fieldName)
+	v.typeChecks = append(v.typeChecks, func(t reflect.Type, path string) error {
+		if t == jsonNumberType {
+			return nil
+		}
+		return kindCheck("Number()", numberKinds...)(t, path)
+	})
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Type() == jsonNumberType {
+			n, ok := jsonNumberOf(e.field.String())
+			if !ok {
+				return abort(newValidationError(CodeNotANumber, nil, notANumberMsg, cmsg, e.fieldName))
+			}
+			e.field = n
+		}
+		if !slices.Contains(numberKinds, e.field.Kind()) {
+			return abort(newValidationError(CodeNotANumber, nil, notANumberMsg, cmsg, e.fieldName))
 		}
 		return nil
 	})
@@ -42,13 +92,25 @@ func (v *BaseValidator) Number(msg ...string) *NumberValidator {
 	return &NumberValidator{v}
 }
 
+// jsonNumberOf converts a [json.Number] to an int64 if it's an integer, to a float64 otherwise
+func jsonNumberOf(n string) (reflect.Value, bool) {
+	if i, err := strconv.ParseInt(n, 10, 64); err == nil {
+		return reflect.ValueOf(i), true
+	}
+	f, err := strconv.ParseFloat(n, 64)
+	if err != nil {
+		return reflect.Value{}, false
+	}
+	return reflect.ValueOf(f), true
+}
+
 // NonZero checks if the field is not "0"
 func (v *NumberValidator) NonZero(msg ...string) *NumberValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if v.field.IsZero() {
-			return newValidationError(zeroNumberErrorMsg, cmsg, v.fieldName)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.IsZero() {
+			return newValidationError(CodeNumberNonZero, nil, zeroNumberErrorMsg, cmsg, e.fieldName)
 		}
 		return nil
 	})
@@ -64,7 +126,7 @@ func (v *NumberValidator) Positive(msg ...string) *NumberValidator {
 		cmsg = notAPositiveNumberMsg
 	}
 
-	return v.Min(1, cmsg)
+	return v.min(1, CodeNumberPositive, cmsg)
 }
 
 // Negative checks if the field is a negative number (< 0)
@@ -76,7 +138,7 @@ func (v *NumberValidator) Negative(msg ...string) *NumberValidator {
 		cmsg = notANegativeNumberMsg
 	}
 
-	return v.Max(-1, cmsg)
+	return v.max(-1, CodeNumberNegative, cmsg)
 }
 
 // NonNegative checks if the field is a non-negative number (>= 0)
@@ -86,7 +148,7 @@ func (v *NumberValidator) NonNegative(msg ...string) *NumberValidator {
 		cmsg = notANonNegativeNumberMsg
 	}
 
-	return v.Min(0, cmsg)
+	return v.min(0, CodeNumberNonNegative, cmsg)
 }
 
 // NonPositive checks if the field is a non-positive number (<= 0)
@@ -96,25 +158,33 @@ func (v *NumberValidator) NonPositive(msg ...string) *NumberValidator {
 		cmsg = notANonPositiveNumberMsg
 	}
 
-	return v.Max(0, cmsg)
+	return v.max(0, CodeNumberNonPositive, cmsg)
 }
 
 // Min checks if the field is greater than or equal to the provided value
 func (v *NumberValidator) Min(min int, msg ...string) *NumberValidator {
-	cmsg := optional(msg)
+	return v.min(min, CodeNumberMin, optional(msg))
+}
 
-	v.validations = append(v.validations, func() error {
-		switch v.field.Kind() {
+// min checks if the field is greater than or equal to the provided value, reporting the provided code on failure
+func (v *NumberValidator) min(min int, code string, cmsg string) *NumberValidator {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		switch e.field.Kind() {
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			if v.field.Int() < int64(min) {
-				return newValidationError(minNumberErrorMsg, cmsg, v.fieldName, min)
+			if e.field.Int() < int64(min) {
+				return newValidationError(code, map[string]any{"min": min}, minNumberErrorMsg, cmsg, e.fieldName, min)
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			// Unsigned values are always greater than negative bounds
+			if min > 0 && e.field.Uint() < uint64(min) {
+				return newValidationError(code, map[string]any{"min": min}, minNumberErrorMsg, cmsg, e.fieldName, min)
 			}
 		case reflect.Float64, reflect.Float32:
-			if v.field.Float() < float64(min) {
-				return newValidationError(minNumberErrorMsg, cmsg, v.fieldName, min)
+			if e.field.Float() < float64(min) {
+				return newValidationError(code, map[string]any{"min": min}, minNumberErrorMsg, cmsg, e.fieldName, min)
 			}
 		default:
-			logger.Panicf("unsupported type %v for Min(), can only be used with int or float", v.field.Kind())
+			return newSchemaDefinitionError(e.path, "unsupported type %v for Min(), can only be used with numbers", e.field.Kind())
 		}
 
 		return nil
@@ -125,20 +195,28 @@ func (v *NumberValidator) Min(min int, msg ...string) *NumberValidator {
 
 // Max checks if the field is less than or equal to the provided value
 func (v *NumberValidator) Max(max int, msg ...string) *NumberValidator {
-	cmsg := optional(msg)
+	return v.max(max, CodeNumberMax, optional(msg))
+}
 
-	v.validations = append(v.validations, func() error {
-		switch v.field.Kind() {
+// max checks if the field is less than or equal to the provided value, reporting the provided code on failure
+func (v *NumberValidator) max(max int, code string, cmsg string) *NumberValidator {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		switch e.field.Kind() {
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			if v.field.Int() > int64(max) {
-				return newValidationError(maxNumberErrorMsg, cmsg, v.fieldName, max)
+			if e.field.Int() > int64(max) {
+				return newValidationError(code, map[string]any{"max": max}, maxNumberErrorMsg, cmsg, e.fieldName, max)
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			// Unsigned values always exceed a negative maximum, so it fails for any of them
+			if max < 0 || e.field.Uint() > uint64(max) {
+				return newValidationError(code, map[string]any{"max": max}, maxNumberErrorMsg, cmsg, e.fieldName, max)
 			}
 		case reflect.Float64, reflect.Float32:
-			if v.field.Float() > float64(max) {
-				return newValidationError(maxNumberErrorMsg, cmsg, v.fieldName, max)
+			if e.field.Float() > float64(max) {
+				return newValidationError(code, map[string]any{"max": max}, maxNumberErrorMsg, cmsg, e.fieldName, max)
 			}
 		default:
-			logger.Panicf("unsupported type %v for Max(), can only be used with int or float", v.field.Kind())
+			return newSchemaDefinitionError(e.path, "unsupported type %v for Max(), can only be used with numbers", e.field.Kind())
 		}
 
 		return nil
@@ -154,17 +232,22 @@ func (v *NumberValidator) Max(max int, msg ...string) *NumberValidator {
 //	func(ctx corretto.Context, value int) error
 //
 // NOTE: Currently custom validation can only be used with Integers. If the field is a float, it will be converted to an int before being passed to the function
+// Unsigned values that don't fit in an int are reported as out of range without calling the function
 func (v *NumberValidator) Test(f CustomValidationFunc[int]) *NumberValidator {
-	v.validations = append(v.validations, func() error {
-		switch v.field.Kind() {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		switch e.field.Kind() {
 		case reflect.Float64, reflect.Float32:
-			return f(v.ctx, int(v.field.Float()))
+			return customError(f(e.ctx, int(e.field.Float())))
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			return f(v.ctx, int(v.field.Int()))
+			return customError(f(e.ctx, int(e.field.Int())))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if e.field.Uint() > math.MaxInt {
+				return newValidationError(CodeNumberOutOfRange, nil, outOfRangeNumberMsg, "", e.fieldName)
+			}
+			return customError(f(e.ctx, int(e.field.Uint())))
 		default:
-			logger.Panicf("unsupported type %v for Test(), can only be used with int or float", v.field.Kind())
+			return newSchemaDefinitionError(e.path, "unsupported type %v for Test(), can only be used with numbers", e.field.Kind())
 		}
-		return nil
 	})
 	return v
 }
@@ -175,19 +258,22 @@ func (v *NumberValidator) Test(f CustomValidationFunc[int]) *NumberValidator {
 func (v *NumberValidator) OneOf(allowed []int, msg ...string) *NumberValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		var val int
-		switch v.field.Kind() {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		var found bool
+		switch e.field.Kind() {
 		case reflect.Float64, reflect.Float32:
-			val = int(v.field.Float())
+			found = oneOf(int(e.field.Float()), allowed)
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			val = int(v.field.Int())
+			found = slices.ContainsFunc(allowed, func(a int) bool { return int64(a) == e.field.Int() })
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			// Negative values can't be compared as unsigned, they never match
+			found = slices.ContainsFunc(allowed, func(a int) bool { return a >= 0 && uint64(a) == e.field.Uint() })
 		default:
-			logger.Panicf("unsupported type %v for OneOf(), can only be used with int or float", v.field.Kind())
+			return newSchemaDefinitionError(e.path, "unsupported type %v for OneOf(), can only be used with numbers", e.field.Kind())
 		}
 
-		if !oneOf(val, allowed) {
-			return newValidationError(oneOfErrorMsg, cmsg, v.fieldName, allowed)
+		if !found {
+			return newValidationError(CodeNumberOneOf, map[string]any{"allowed": allowed}, oneOfErrorMsg, cmsg, e.fieldName, allowed)
 		}
 		return nil
 	})
@@ -202,18 +288,24 @@ func (v *NumberValidator) OneOf(allowed []int, msg ...string) *NumberValidator {
 func (v *NumberValidator) MultipleOf(divisor int, msg ...string) *NumberValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		var val int
-		switch v.field.Kind() {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		var multiple bool
+		switch e.field.Kind() {
 		case reflect.Float64, reflect.Float32:
-			val = int(v.field.Float())
+			multiple = int(e.field.Float())%divisor == 0
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			val = int(v.field.Int())
+			multiple = e.field.Int()%int64(divisor) == 0
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			d := divisor
+			if d < 0 {
+				d = -d
+			}
+			multiple = e.field.Uint()%uint64(d) == 0
 		default:
-			logger.Panicf("unsupported type %v for MultipleOf(), can only be used with int or float", v.field.Kind())
+			return newSchemaDefinitionError(e.path, "unsupported type %v for MultipleOf(), can only be used with numbers", e.field.Kind())
 		}
-		if val%divisor != 0 {
-			return newValidationError(notAMultipleOfMsg, cmsg, v.fieldName, divisor)
+		if !multiple {
+			return newValidationError(CodeNumberMultipleOf, map[string]any{"divisor": divisor}, notAMultipleOfMsg, cmsg, e.fieldName, divisor)
 		}
 		return nil
 	})
@@ -225,21 +317,288 @@ func (v *NumberValidator) MultipleOf(divisor int, msg ...string) *NumberValidato
 func (v *NumberValidator) Finite(msg ...string) *NumberValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		switch v.field.Kind() {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		switch e.field.Kind() {
 		case reflect.Float64, reflect.Float32:
-			if math.IsInf(v.field.Float(), 0) {
-				return newValidationError(notAFiniteNumberMsg, cmsg, v.fieldName)
+			if math.IsInf(e.field.Float(), 0) {
+				return newValidationError(CodeNumberFinite, nil, notAFiniteNumberMsg, cmsg, e.fieldName)
 			}
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			if math.IsInf(float64(v.field.Int()), 0) {
-				return newValidationError(notAFiniteNumberMsg, cmsg, v.fieldName)
+			if math.IsInf(float64(e.field.Int()), 0) {
+				return newValidationError(CodeNumberFinite, nil, notAFiniteNumberMsg, cmsg, e.fieldName)
 			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			// Unsigned integers are always finite
+		default:
+			return newSchemaDefinitionError(e.path, "unsupported type %v for Finite(), can only be used with numbers", e.field.Kind())
+		}
+		return nil
+	})
+
+	return v
+}
+
+// Bounds defines which ends of the range are included by [NumberValidator.Between]
+type Bounds int
+
+const (
+	InclusiveBounds Bounds = iota // Both ends are included, [min, max]
+	ExclusiveBounds               // Both ends are excluded, (min, max)
+	InclusiveMin                  // Only the min is included, [min, max)
+	InclusiveMax                  // Only the max is included, (min, max]
+)
+
+// format returns the range in interval notation, e.g. [0.1, 0.5)
+func (b Bounds) format(min float64, max float64) string {
+	open, close := "[", "]"
+	if b == ExclusiveBounds || b == InclusiveMax {
+		open = "("
+	}
+	if b == ExclusiveBounds || b == InclusiveMin {
+		close = ")"
+	}
+	return fmt.Sprintf("%s%v, %v%s", open, min, max, close)
+}
+
+// floatOf returns the value of the numeric field as a float64
+func floatOf(field reflect.Value) float64 {
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(field.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(field.Uint())
+	default:
+		return field.Float()
+	}
+}
+
+// MinFloat checks if the field is greater than or equal to the provided value
+//
+// Unlike [NumberValidator.Min] it accepts a float bound, e.g. MinFloat(0.01)
+func (v *NumberValidator) MinFloat(min float64, msg ...string) *NumberValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if floatOf(e.field) < min {
+			return newValidationError(CodeNumberMin, map[string]any{"min": min}, minNumberErrorMsg, cmsg, e.fieldName, min)
+		}
+		return nil
+	})
+	return v
+}
+
+// MaxFloat checks if the field is less than or equal to the provided value
+//
+// Unlike [NumberValidator.Max] it accepts a float bound, e.g. MaxFloat(0.5)
+func (v *NumberValidator) MaxFloat(max float64, msg ...string) *NumberValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if floatOf(e.field) > max {
+			return newValidationError(CodeNumberMax, map[string]any{"max": max}, maxNumberErrorMsg, cmsg, e.fieldName, max)
+		}
+		return nil
+	})
+	return v
+}
+
+// GreaterThan checks if the field is strictly greater than the provided value
+func (v *NumberValidator) GreaterThan(n float64, msg ...string) *NumberValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if !(floatOf(e.field) > n) {
+			return newValidationError(CodeNumberGreaterThan, map[string]any{"min": n}, greaterThanNumberMsg, cmsg, e.fieldName, n)
+		}
+		return nil
+	})
+	return v
+}
+
+// LessThan checks if the field is strictly less than the provided value
+func (v *NumberValidator) LessThan(n float64, msg ...string) *NumberValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if !(floatOf(e.field) < n) {
+			return newValidationError(CodeNumberLessThan, map[string]any{"max": n}, lessThanNumberMsg, cmsg, e.fieldName, n)
+		}
+		return nil
+	})
+	return v
+}
+
+// Between checks if the field is in the range between min and max, the bounds define whether the ends are included
+//
+//	corretto.Field().Number().Between(0, 1, corretto.InclusiveMin) // 0 <= value < 1
+func (v *NumberValidator) Between(min float64, max float64, bounds Bounds, msg ...string) *NumberValidator {
+	cmsg := optional(msg)
+	interval := bounds.format(min, max)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		val := floatOf(e.field)
+
+		var aboveMin, belowMax bool
+		if bounds == InclusiveBounds || bounds == InclusiveMin {
+			aboveMin = val >= min
+		} else {
+			aboveMin = val > min
+		}
+		if bounds == InclusiveBounds || bounds == InclusiveMax {
+			belowMax = val <= max
+		} else {
+			belowMax = val < max
+		}
+
+		if !aboveMin || !belowMax {
+			return newValidationError(CodeNumberBetween, map[string]any{"min": min, "max": max, "bounds": interval}, betweenNumberMsg, cmsg, e.fieldName, interval)
+		}
+		return nil
+	})
+	return v
+}
+
+// MultipleOfFloat checks if the field value is a multiple of the provided divisor,
+// the value may differ from the nearest multiple up to the tolerance to account for the float precision
+//
+//	corretto.Field().Number().MultipleOfFloat(0.01, 1e-9) // at most 2 decimal places
+func (v *NumberValidator) MultipleOfFloat(divisor float64, tolerance float64, msg ...string) *NumberValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		val := floatOf(e.field)
+		// Distance from the nearest multiple of the divisor
+		if math.Abs(val-math.Round(val/divisor)*divisor) > tolerance {
+			return newValidationError(CodeNumberMultipleOf, map[string]any{"divisor": divisor, "tolerance": tolerance}, notAMultipleOfMsg, cmsg, e.fieldName, divisor)
+		}
+		return nil
+	})
+	return v
+}
+
+// TestFloat allows you to run a custom validation function receiving the value as a float64,
+// unlike [NumberValidator.Test] it doesn't truncate floats
+//
+// The function should have the signature:
+//
+//	func(ctx corretto.Context, value float64) error
+func (v *NumberValidator) TestFloat(f CustomValidationFunc[float64]) *NumberValidator {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		return customError(f(e.ctx, floatOf(e.field)))
+	})
+	return v
+}
+
+// NumberTest allows you to run a custom validation function receiving the value with its original numeric type N
+//
+//	corretto.NumberTest(corretto.Field().Number(), func(ctx corretto.Context, value uint16) error {
+//		...
+//	})
+//
+// It returns a [SchemaDefinitionError] when parsing if the field is not of type N
+func NumberTest[N Number](v *NumberValidator, f CustomValidationFunc[N]) *NumberValidator {
+	t := reflect.TypeFor[N]()
+
+	v.typeChecks = append(v.typeChecks, func(ft reflect.Type, path string) error {
+		if ft.Kind() != t.Kind() {
+			return newSchemaDefinitionError(path, "NumberTest[%v]() can't be used on field %s of type %v", t, path, ft)
+		}
+		return nil
+	})
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Kind() != t.Kind() {
+			return newSchemaDefinitionError(e.path, "NumberTest[%v]() can't be used on field %s of type %v", t, e.path, e.field.Type())
+		}
+		return customError(f(e.ctx, e.field.Convert(t).Interface().(N)))
+	})
+	return v
+}
+
+// Clamp limits the field to the range between min and max, values outside of it are replaced by the nearest bound
+//
+// When a pointer is passed to Parse the field of the struct is updated, the validations following it see the clamped value either way.
+// A bound that can't be stored in the field (e.g. 0.5 for an int) is reported with a [SchemaDefinitionError] when the field is clamped to it
+//
+//	"PageSize": corretto.Field().Number().Clamp(1, 100),
+func (v *NumberValidator) Clamp(min float64, max float64) *NumberValidator {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		var bound float64
+		switch val := floatOf(e.field); {
+		case val < min:
+			bound = min
+		case val > max:
+			bound = max
 		default:
-			logger.Panicf("unsupported type %v for Finite(), can only be used with int or float", v.field.Kind())
+			// Values in range are left untouched, so that large integers don't lose precision
+			return nil
+		}
+
+		if err := fitCheck("Clamp()", bound, e.field.Type(), e.path); err != nil {
+			return err
 		}
+		e.set(reflect.ValueOf(bound))
 		return nil
 	})
+	return v
+}
 
+// Round rounds the field to the provided number of decimal places (half away from zero), integers are left untouched,
+// see [NumberValidator.Clamp] for how the field is updated
+//
+//	"Price": corretto.Field().Number().Round(2),
+func (v *NumberValidator) Round(decimals int) *NumberValidator {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if !slices.Contains(floatKinds, e.field.Kind()) {
+			return nil
+		}
+		p := math.Pow10(decimals)
+		e.set(reflect.ValueOf(math.Round(e.field.Float()*p) / p))
+		return nil
+	})
 	return v
 }
+
+// Default sets the field to the provided value when it's zero or a nil pointer, before running the validations.
+// The value is converted to the type of the field, a value that can't be stored in it (e.g. 300 for a uint8 or 2.5 for an int)
+// is reported with a [SchemaDefinitionError]
+//
+// When a pointer is passed to Parse the field of the struct is updated, the validations see the default value either way
+//
+//	"PageSize": corretto.Field().Number().Default(20).Max(100),
+func (v *NumberValidator) Default(value float64) *NumberValidator {
+	v.typeChecks = append(v.typeChecks, func(t reflect.Type, path string) error {
+		return fitCheck("default", value, t, path)
+	})
+	return v.DefaultFunc(func() float64 { return value })
+}
+
+// DefaultFunc behaves the same as [NumberValidator.Default] but the value is computed by f every time it's needed
+func (v *NumberValidator) DefaultFunc(f func() float64) *NumberValidator {
+	v.setDefault(func() any { return f() }, reflect.TypeFor[float64]())
+	return v
+}
+
+// fitsIn reports whether the number can be stored in a field of type t without changing its value,
+// i.e. it doesn't overflow the type and it has no fractional part if t is an integer type
+func fitsIn(n float64, t reflect.Type) bool {
+	switch {
+	case slices.Contains(intKinds, t.Kind()):
+		return n == math.Trunc(n) && n >= math.MinInt64 && n < -math.MinInt64 && !reflect.Zero(t).OverflowInt(int64(n))
+	case slices.Contains(uintKinds, t.Kind()):
+		return n == math.Trunc(n) && n >= 0 && n < 1<<64 && !reflect.Zero(t).OverflowUint(uint64(n))
+	case slices.Contains(floatKinds, t.Kind()):
+		return !reflect.Zero(t).OverflowFloat(n)
+	}
+	return true
+}
+
+// fitCheck returns a [SchemaDefinitionError] if the number set by the rule can't be stored in a field of type t, see [fitsIn]
+func fitCheck(rule string, n float64, t reflect.Type, path string) error {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if !fitsIn(n, t) {
+		return newSchemaDefinitionError(path, "%s value %v can't be stored in field %s of type %v", rule, n, path, t)
+	}
+	return nil
+}
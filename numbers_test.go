@@ -1,8 +1,10 @@
 package corretto
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"reflect"
 	"testing"
 )
 
@@ -407,3 +409,221 @@ func TestNumberFinite(t *testing.T) {
 		})
 	}
 }
+
+func TestNumberUnsigned(t *testing.T) {
+	tests := []struct {
+		name        string
+		validator   validator
+		value       any
+		expectError bool
+	}{
+		{"uint is a number", Field().Number(), uint(42), false},
+		{"uint8 is a number", Field().Number(), uint8(42), false},
+		{"uint16 is a number", Field().Number(), uint16(42), false},
+		{"uint32 is a number", Field().Number(), uint32(42), false},
+		{"uint64 is a number", Field().Number(), uint64(42), false},
+		{"uintptr is a number", Field().Number(), uintptr(42), false},
+		{"min with lower value", Field().Number().Min(10), uint(5), true},
+		{"min with greater value", Field().Number().Min(10), uint(15), false},
+		{"min with negative bound", Field().Number().Min(-10), uint(0), false},
+		{"max with greater value", Field().Number().Max(10), uint(15), true},
+		{"max with lower value", Field().Number().Max(10), uint(5), false},
+		{"max with negative bound", Field().Number().Max(-1), uint(0), true},
+		{"max with huge value", Field().Number().Max(math.MaxInt), uint64(math.MaxUint64), true},
+		{"positive with zero", Field().Number().Positive(), uint(0), true},
+		{"non zero with zero", Field().Number().NonZero(), uint8(0), true},
+		{"one of with allowed value", Field().Number().OneOf([]int{1, 2, 3}), uint16(2), false},
+		{"one of with not allowed value", Field().Number().OneOf([]int{1, 2, 3}), uint16(4), true},
+		{"one of with huge value", Field().Number().OneOf([]int{-1}), uint64(math.MaxUint64), true},
+		{"multiple of", Field().Number().MultipleOf(3), uint32(9), false},
+		{"not multiple of", Field().Number().MultipleOf(3), uint32(10), true},
+		{"multiple of negative divisor", Field().Number().MultipleOf(-3), uint32(9), false},
+		{"finite", Field().Number().Finite(), uint64(math.MaxUint64), false},
+		{"test", Field().Number().Test(func(ctx Context, value int) error { return nil }), uint(42), false},
+		{"test with huge value", Field().Number().Test(func(ctx Context, value int) error { return nil }), uint64(math.MaxUint64), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := reflect.New(reflect.StructOf([]reflect.StructField{{Name: "Field1", Type: reflect.TypeOf(tt.value)}})).Elem()
+			v.Field(0).Set(reflect.ValueOf(tt.value))
+
+			err := Schema{"Field1": tt.validator}.Parse(v.Interface())
+			if tt.expectError && err == nil {
+				t.Errorf("Parse() should have returned an error")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Parse() should have returned nil, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNumberFloatBounds(t *testing.T) {
+	tests := []struct {
+		name        string
+		validator   validator
+		value       any
+		expectError bool
+	}{
+		{"min float with lower value", Field().Number().MinFloat(0.5), 0.49, true},
+		{"min float with equal value", Field().Number().MinFloat(0.5), 0.5, false},
+		{"min float with int", Field().Number().MinFloat(0.5), 1, false},
+		{"min float doesn't truncate", Field().Number().MinFloat(0.5), 0, true},
+		{"max float with greater value", Field().Number().MaxFloat(0.5), 0.51, true},
+		{"max float with equal value", Field().Number().MaxFloat(0.5), float32(0.5), false},
+		{"max float with uint", Field().Number().MaxFloat(0.5), uint(1), true},
+		{"greater than with equal value", Field().Number().GreaterThan(0), 0.0, true},
+		{"greater than with greater value", Field().Number().GreaterThan(0), 0.001, false},
+		{"greater than with NaN", Field().Number().GreaterThan(0), math.NaN(), true},
+		{"less than with equal value", Field().Number().LessThan(1), 1, true},
+		{"less than with lower value", Field().Number().LessThan(1), 0.999, false},
+		{"between inclusive", Field().Number().Between(0, 1, InclusiveBounds), 1.0, false},
+		{"between inclusive outside", Field().Number().Between(0, 1, InclusiveBounds), 1.01, true},
+		{"between exclusive min", Field().Number().Between(0, 1, ExclusiveBounds), 0.0, true},
+		{"between exclusive max", Field().Number().Between(0, 1, ExclusiveBounds), 1.0, true},
+		{"between exclusive inside", Field().Number().Between(0, 1, ExclusiveBounds), 0.5, false},
+		{"between inclusive min", Field().Number().Between(0, 1, InclusiveMin), int8(0), false},
+		{"between inclusive min with max", Field().Number().Between(0, 1, InclusiveMin), 1.0, true},
+		{"between inclusive max", Field().Number().Between(0, 1, InclusiveMax), 1.0, false},
+		{"between inclusive max with min", Field().Number().Between(0, 1, InclusiveMax), uint(0), true},
+		{"multiple of float", Field().Number().MultipleOfFloat(0.01, 1e-9), 19.99, false},
+		{"not multiple of float", Field().Number().MultipleOfFloat(0.01, 1e-9), 19.999, true},
+		{"multiple of float with int", Field().Number().MultipleOfFloat(0.5, 1e-9), 3, false},
+		{"multiple of float with large tolerance", Field().Number().MultipleOfFloat(0.1, 0.06), 0.15, false},
+		{"test float", Field().Number().TestFloat(func(ctx Context, value float64) error {
+			if value != 0.25 {
+				return fmt.Errorf("expected 0.25, got %v", value)
+			}
+			return nil
+		}), 0.25, false},
+		{"test float with uint", Field().Number().TestFloat(func(ctx Context, value float64) error {
+			if value != 42 {
+				return fmt.Errorf("expected 42, got %v", value)
+			}
+			return nil
+		}), uint8(42), false},
+		{"number test", NumberTest(Field().Number(), func(ctx Context, value uint16) error {
+			if value != 42 {
+				return fmt.Errorf("expected 42, got %v", value)
+			}
+			return nil
+		}), uint16(42), false},
+		{"number test failure", NumberTest(Field().Number(), func(ctx Context, value float32) error {
+			return fmt.Errorf("invalid %v", value)
+		}), float32(0.5), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := reflect.New(reflect.StructOf([]reflect.StructField{{Name: "Field1", Type: reflect.TypeOf(tt.value)}})).Elem()
+			v.Field(0).Set(reflect.ValueOf(tt.value))
+
+			err := Schema{"Field1": tt.validator}.Parse(v.Interface())
+			if tt.expectError && err == nil {
+				t.Errorf("Parse() should have returned an error")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Parse() should have returned nil, got %v", err)
+			}
+		})
+	}
+
+	t.Run("between reports the interval", func(t *testing.T) {
+		err := Schema{"Field1": Field().Number().Between(0, 1, InclusiveMin)}.Parse(struct{ Field1 float64 }{Field1: 1})
+
+		var vErr *ValidationError
+		if !errors.As(err, &vErr) || vErr.Code != CodeNumberBetween {
+			t.Fatalf("expected a %s error, got %v", CodeNumberBetween, err)
+		}
+		if vErr.Message != "Field1 must be in the range [0, 1)" {
+			t.Errorf("unexpected message %q", vErr.Message)
+		}
+	})
+
+	t.Run("number test with a different type", func(t *testing.T) {
+		s := Schema{"Field1": NumberTest(Field().Number(), func(ctx Context, value int) error { return nil })}
+
+		var defErr *SchemaDefinitionError
+		if err := s.Parse(struct{ Field1 int64 }{Field1: 1}); !errors.As(err, &defErr) {
+			t.Errorf("expected a SchemaDefinitionError, got %v", err)
+		}
+		if _, err := s.Compile(reflect.TypeOf(struct{ Field1 int64 }{})); !errors.As(err, &defErr) {
+			t.Errorf("expected a SchemaDefinitionError from Compile, got %v", err)
+		}
+	})
+}
+
+func TestNumberTransform(t *testing.T) {
+	t.Run("clamp", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			value    int
+			expected int
+		}{
+			{"lower value", -5, 1},
+			{"greater value", 500, 100},
+			{"value in range", 20, 20},
+		}
+
+		schema := Schema{"PageSize": Field().Number().Clamp(1, 100).Min(1)}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				v := &struct{ PageSize int }{PageSize: tt.value}
+				if err := schema.Parse(v); err != nil {
+					t.Fatalf("Parse() should have returned nil, got %v", err)
+				}
+				if v.PageSize != tt.expected {
+					t.Errorf("expected %d, got %d", tt.expected, v.PageSize)
+				}
+			})
+		}
+	})
+
+	t.Run("clamp to a bound that doesn't fit the field", func(t *testing.T) {
+		schema := Schema{"PageSize": Field().Number().Clamp(0.5, 10)}
+
+		v := &struct{ PageSize int }{PageSize: 0}
+		var defErr *SchemaDefinitionError
+		if err := schema.Parse(v); !errors.As(err, &defErr) || v.PageSize != 0 {
+			t.Errorf("expected a SchemaDefinitionError, got %v (%d)", err, v.PageSize)
+		}
+		v.PageSize = 20
+		if err := schema.Parse(v); err != nil || v.PageSize != 10 {
+			t.Errorf("Parse() should have clamped the field to 10, got %v (%d)", err, v.PageSize)
+		}
+	})
+
+	t.Run("round", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			decimals int
+			value    float64
+			expected float64
+		}{
+			{"two decimals", 2, 19.987, 19.99},
+			{"no decimals", 0, 2.5, 3},
+			{"negative value", 1, -1.25, -1.3},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				v := &struct{ Price float64 }{Price: tt.value}
+				if err := (Schema{"Price": Field().Number().Round(tt.decimals)}).Parse(v); err != nil {
+					t.Fatalf("Parse() should have returned nil, got %v", err)
+				}
+				if v.Price != tt.expected {
+					t.Errorf("expected %v, got %v", tt.expected, v.Price)
+				}
+			})
+		}
+	})
+
+	t.Run("following rules see the transformed value", func(t *testing.T) {
+		schema := Schema{"Price": Field().Number().Round(2).MultipleOfFloat(0.01, 1e-9)}
+
+		if err := schema.Parse(struct{ Price float32 }{Price: 9.999}); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+	})
+}
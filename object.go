@@ -0,0 +1,238 @@
+package corretto
+
+import (
+	"reflect"
+	"slices"
+)
+
+// Shape is implemented by [Schema] and [ObjectSchema], so that both can be nested (see [BaseValidator.Schema]) and composed
+type Shape interface {
+	object() ObjectSchema
+}
+
+// ObjectSchema is a [Schema] with options on the whole value, e.g. how the keys it doesn't declare are handled
+// (see [Schema.Strict]) or the rules that involve more than one field (see [Schema.Refine])
+//
+// It's returned by the methods that set them, and it can be nested, composed and used to parse values like a [Schema].
+// It's immutable, every method returns a new one
+//
+//	userSchema := corretto.Schema{
+//		"Name": corretto.Field().String().NonEmpty(),
+//	}.Strict()
+type ObjectSchema struct {
+	schema  Schema // The validators of the fields, they are never changed once the object schema is created
+	options schemaOptions
+}
+
+func (s Schema) object() ObjectSchema {
+	return ObjectSchema{schema: s}
+}
+
+func (o ObjectSchema) object() ObjectSchema {
+	return o
+}
+
+// Fields returns a copy of the fields of the schema, without its options
+func (o ObjectSchema) Fields() Schema {
+	return o.schema.clone(nil)
+}
+
+const (
+	unknownKeysErrorMsg = "%v has unknown keys %v"
+	documentName        = "document" // The field name of the errors at the root of the document
+)
+
+// CodeUnknownKeys is the [ValidationError.Code] reported by a strict schema when the value has keys it doesn't declare,
+// the keys are listed in the "keys" parameter
+const CodeUnknownKeys = "schema.unknown_keys"
+
+// unknownKeysMode defines how the keys not declared in the schema are handled
+type unknownKeysMode int
+
+const (
+	passthroughUnknownKeys unknownKeysMode = iota // Unknown keys are kept, the default
+	stripUnknownKeys                              // Unknown keys are removed from map documents
+	strictUnknownKeys                             // Unknown keys are reported as errors
+)
+
+// schemaCheck validates the whole value of the schema, the parent is the evaluation of the field holding it
+type schemaCheck func(value any, parent *evalContext) error
+
+// schemaOptions holds the options of an [ObjectSchema]
+type schemaOptions struct {
+	unknownKeys    unknownKeysMode
+	unknownKeysMsg string        // The custom message of the unknown keys error
+	checks         []schemaCheck // The checks of the whole value, run after the fields are validated (e.g. [DiscriminatedUnion])
+	typeChecks     []typeCheck   // The checks on the type of the value performed by [Schema.Compile]
+	unions         []union       // The alternatives of the unions among the checks, see [OneOfSchemas] and [DiscriminatedUnion]
+	lazy           *lazySchema   // The schema resolved when it's used, see [Lazy]
+	maxDepth       int           // The maximum number of nested lazy schemas, see [ObjectSchema.MaxDepth]
+	panics         bool          // Whether parsing panics on a [SchemaDefinitionError], see [Schema.PanicOnDefinitionError]
+}
+
+// withCheck returns a copy of the schema with a check of the whole value, and its type check if any
+func (o ObjectSchema) withCheck(check schemaCheck, tc typeCheck) ObjectSchema {
+	o.options.checks = append(slices.Clip(o.options.checks), check)
+	if tc != nil {
+		o.options.typeChecks = append(slices.Clip(o.options.typeChecks), tc)
+	}
+	return o
+}
+
+// Strict returns a schema that reports the keys it doesn't declare with a [ValidationError]
+// with code [CodeUnknownKeys] listing them. It applies to map documents (see [Schema.Parse])
+// and to the JSON data decoded by [Schema.Unmarshal] and [Schema.Decode]
+//
+//	err := schema.Strict().Parse(map[string]any{"Name": "John", "Admin": true}) // "document has unknown keys [Admin]"
+//
+// Nested schemas are not affected, make them strict too if needed
+func (s Schema) Strict(msg ...string) ObjectSchema {
+	return s.object().Strict(msg...)
+}
+
+// Strip returns a schema that silently removes the keys it doesn't declare from map documents
+//
+// When decoding JSON into a struct the keys that are not fields of the struct are dropped anyway,
+// so it behaves the same as [Schema.Passthrough]
+func (s Schema) Strip() ObjectSchema {
+	return s.object().Strip()
+}
+
+// Passthrough returns a schema that ignores the keys it doesn't declare, this is the default
+func (s Schema) Passthrough() ObjectSchema {
+	return s.object().Passthrough()
+}
+
+// Strict returns a copy of the schema that reports the keys it doesn't declare, see [Schema.Strict]
+func (o ObjectSchema) Strict(msg ...string) ObjectSchema {
+	o.options.unknownKeys, o.options.unknownKeysMsg = strictUnknownKeys, optional(msg)
+	return o
+}
+
+// Strip returns a copy of the schema that removes the keys it doesn't declare, see [Schema.Strip]
+func (o ObjectSchema) Strip() ObjectSchema {
+	o.options.unknownKeys = stripUnknownKeys
+	return o
+}
+
+// Passthrough returns a copy of the schema that ignores the keys it doesn't declare, see [Schema.Passthrough]
+func (o ObjectSchema) Passthrough() ObjectSchema {
+	o.options.unknownKeys = passthroughUnknownKeys
+	return o
+}
+
+// PanicOnDefinitionError returns a schema that panics when the schema doesn't match the value (see [SchemaDefinitionError])
+// instead of returning the error, while the validations that fail are still returned as errors.
+// Unlike [Schema.MustParse] it's meant to be used in production, where a bug in the schema should never go unnoticed
+//
+//	userSchema := corretto.Schema{
+//		"Name": corretto.Field().String().NonEmpty(),
+//	}.PanicOnDefinitionError()
+//
+// It applies to every way of parsing a value, [CompiledSchema.Parse] and [ObjectSchema.Unmarshal] included
+func (s Schema) PanicOnDefinitionError() ObjectSchema {
+	return s.object().PanicOnDefinitionError()
+}
+
+// PanicOnDefinitionError returns a copy of the schema that panics on a [SchemaDefinitionError], see [Schema.PanicOnDefinitionError]
+func (o ObjectSchema) PanicOnDefinitionError() ObjectSchema {
+	o.options.panics = true
+	return o
+}
+
+// Parse behaves the same as [Schema.Parse]
+func (o ObjectSchema) Parse(value any) error {
+	return o.result(o.parse(value, rootContext(value, false)))
+}
+
+// ParseAll behaves the same as [Schema.ParseAll]
+func (o ObjectSchema) ParseAll(value any) error {
+	return o.result(o.parse(value, rootContext(value, true)))
+}
+
+// result returns the error of a parse, panicking if it's a [SchemaDefinitionError] and the schema panics on them
+func (o ObjectSchema) result(err error) error {
+	if o.options.panics && isSchemaDefinitionError(err) {
+		panic(err)
+	}
+	return err
+}
+
+// MustParse behaves the same as [Schema.MustParse]
+func (o ObjectSchema) MustParse(value any) {
+	err := o.Parse(value)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// hasStrict reports whether the schema, or any schema nested in it (the alternatives of its unions included), is strict
+// The validators already visited are skipped, so that recursive schemas don't loop forever
+func (o ObjectSchema) hasStrict(visited map[*BaseValidator]bool) bool {
+	o = o.resolve()
+	if o.options.unknownKeys == strictUnknownKeys {
+		return true
+	}
+	for _, v := range o.schema {
+		if v.getBaseValidator().hasStrict(visited) {
+			return true
+		}
+	}
+	for _, u := range o.options.unions {
+		for _, alt := range u.alternatives {
+			if alt.hasStrict(visited) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasStrict reports whether the schema of the field (or of its elements) is strict, see [ObjectSchema.hasStrict]
+func (v *BaseValidator) hasStrict(visited map[*BaseValidator]bool) bool {
+	if visited[v] {
+		return false
+	}
+	visited[v] = true
+
+	if v.schema != nil && v.schema.hasStrict(visited) {
+		return true
+	}
+	return v.elements != nil && v.elements.getBaseValidator().hasStrict(visited)
+}
+
+// checkUnknownKeys handles the keys of the map document v not declared in the schema, based on its options
+func (o ObjectSchema) checkUnknownKeys(v reflect.Value, parent *evalContext) error {
+	if o.options.unknownKeys == passthroughUnknownKeys {
+		return nil
+	}
+
+	var unknown []string
+	for _, key := range sortedKeys(v) {
+		if _, ok := o.schema[key.String()]; ok {
+			continue
+		}
+		if o.options.unknownKeys == stripUnknownKeys {
+			if parent.trial != nil {
+				parent.trial.changed = true
+			} else {
+				v.SetMapIndex(key, reflect.Value{})
+			}
+			continue
+		}
+		unknown = append(unknown, key.String())
+	}
+
+	return o.unknownKeysError(unknown, parent)
+}
+
+// unknownKeysError returns the error reporting the unknown keys of the value validated by the parent evaluation,
+// or nil if there are none
+func (o ObjectSchema) unknownKeysError(unknown []string, parent *evalContext) error {
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	err := newValidationError(CodeUnknownKeys, map[string]any{"keys": unknown}, unknownKeysErrorMsg, o.options.unknownKeysMsg, parent.name(), unknown)
+	return parent.schemaError(err)
+}
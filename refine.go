@@ -0,0 +1,96 @@
+package corretto
+
+import (
+	"strings"
+)
+
+// Refine returns a copy of the schema that also runs f on the whole value, once its fields are validated
+// It's meant for the rules that involve more than one field
+//
+//	contactSchema := userSchema.Refine(func(ctx corretto.Context) error {
+//		u := ctx.(User)
+//		if u.Email == nil && u.Phone == nil {
+//			return errors.New("at least one of Email or Phone must be set")
+//		}
+//		return nil
+//	})
+//
+// The error is reported for the value validated by the schema, as for [StringValidator.Test] it's converted to a
+// [ValidationError] with code [CodeCustom] unless it's already one.
+// With [Schema.Parse] it doesn't run if a field is invalid, with [Schema.ParseAll] it always runs, use [Schema.SuperRefine]
+// to report errors for specific fields
+func (s Schema) Refine(f func(ctx Context) error) ObjectSchema {
+	return s.object().Refine(f)
+}
+
+// Refine returns a copy of the schema that also runs f on the whole value, see [Schema.Refine]
+func (o ObjectSchema) Refine(f func(ctx Context) error) ObjectSchema {
+	return o.withCheck(func(value any, parent *evalContext) error {
+		err := customError(f(value))
+		// Errors that already name their field (e.g. the ones of another schema) are kept as they are
+		if vErr, ok := err.(*ValidationError); ok && vErr.Field == "" {
+			return parent.schemaError(vErr)
+		}
+		return err
+	}, nil)
+}
+
+// SuperRefine behaves the same as [Schema.Refine] but f reports the errors through the [Refinement],
+// which can attach them to specific fields and report more than one
+//
+//	signupSchema := userSchema.SuperRefine(func(ctx corretto.Context, r *corretto.Refinement) {
+//		u := ctx.(User)
+//		if u.Email == nil && u.Phone == nil {
+//			r.AddError("Email", errors.New("Email is required when Phone is not set"))
+//			r.AddError("Phone", errors.New("Phone is required when Email is not set"))
+//		}
+//	})
+func (s Schema) SuperRefine(f func(ctx Context, r *Refinement)) ObjectSchema {
+	return s.object().SuperRefine(f)
+}
+
+// SuperRefine returns a copy of the schema that also runs f on the whole value, see [Schema.SuperRefine]
+func (o ObjectSchema) SuperRefine(f func(ctx Context, r *Refinement)) ObjectSchema {
+	return o.withCheck(func(value any, parent *evalContext) error {
+		r := &Refinement{ctx: value, parent: parent}
+		f(value, r)
+		if len(r.errs) == 0 {
+			return nil
+		}
+		if !parent.all {
+			return r.errs[0]
+		}
+		return r.errs
+	}, nil)
+}
+
+// Refinement collects the errors reported by the function of [Schema.SuperRefine]
+type Refinement struct {
+	ctx    Context
+	parent *evalContext // The evaluation of the field holding the value validated by the schema
+	errs   ValidationErrors
+}
+
+// AddError reports an error for the field at the provided path, relative to the value validated by the schema
+// (e.g. "Email" or "Address.City"), if the path is empty the error is reported for the whole value
+//
+// As for [Schema.Refine] the error is converted to a [ValidationError] with code [CodeCustom] unless it's already one,
+// a nil error is ignored
+func (r *Refinement) AddError(path string, err error) {
+	vErr, ok := customError(err).(*ValidationError)
+	if !ok {
+		return
+	}
+	if path == "" {
+		r.errs.add(r.parent.schemaError(vErr))
+		return
+	}
+
+	vErr.Key = path[strings.LastIndex(path, ".")+1:]
+	vErr.Field = vErr.Key
+	vErr.Path = joinPath(r.parent.path, path)
+	if field, ok := siblingOf(r.ctx, path); ok {
+		vErr.Value = valueOf(field)
+	}
+	r.errs.add(vErr)
+}
@@ -0,0 +1,152 @@
+package corretto
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRefine(t *testing.T) {
+	type Contact struct {
+		Name  string
+		Email *string
+		Phone *string
+	}
+
+	email := "john@doe.com"
+	schema := Schema{
+		"Name":  Field().String().NonEmpty(),
+		"Email": Field().Optional().String().Email(),
+		"Phone": Field().Optional().String(),
+	}.Refine(func(ctx Context) error {
+		c := ctx.(Contact)
+		if c.Email == nil && c.Phone == nil {
+			return errors.New("at least one of Email or Phone must be set")
+		}
+		return nil
+	})
+
+	t.Run("passes", func(t *testing.T) {
+		if err := schema.Parse(Contact{Name: "John", Email: &email}); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		err := schema.Parse(Contact{Name: "John"})
+
+		var vErr *ValidationError
+		if !errors.As(err, &vErr) || vErr.Code != CodeCustom || vErr.Path != "" {
+			t.Fatalf("expected a %s error for the whole value, got %v", CodeCustom, err)
+		}
+		if err.Error() != "at least one of Email or Phone must be set" {
+			t.Errorf("unexpected message %q", err.Error())
+		}
+	})
+
+	t.Run("runs after the fields", func(t *testing.T) {
+		var vErr *ValidationError
+		if err := schema.Parse(Contact{}); !errors.As(err, &vErr) || vErr.Code != CodeStringNonEmpty {
+			t.Errorf("expected the error of Name, got %v", err)
+		}
+
+		errs, ok := schema.ParseAll(Contact{}).(ValidationErrors)
+		if !ok || len(errs) != 2 {
+			t.Fatalf("expected the errors of Name and of the refinement, got %v", errs)
+		}
+		if !errors.As(errs[1], &vErr) || vErr.Code != CodeCustom {
+			t.Errorf("expected the refinement error last, got %v", errs[1])
+		}
+	})
+
+	t.Run("nested schemas", func(t *testing.T) {
+		type User struct {
+			Contact Contact
+		}
+
+		err := Schema{"Contact": Field().Schema(schema)}.Parse(User{Contact: Contact{Name: "John"}})
+		var vErr *ValidationError
+		if !errors.As(err, &vErr) || vErr.Path != "Contact" || vErr.Key != "Contact" {
+			t.Errorf("expected an error for Contact, got %v", err)
+		}
+	})
+
+	t.Run("the original schema is not refined", func(t *testing.T) {
+		s := Schema{"Name": Field().String()}
+		_ = s.Refine(func(ctx Context) error { return errors.New("refined") })
+		if err := s.Parse(Contact{}); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+	})
+}
+
+func TestSuperRefine(t *testing.T) {
+	type Address struct {
+		City string
+	}
+	type Contact struct {
+		Email   *string
+		Phone   *string
+		Address Address
+	}
+
+	schema := Schema{
+		"Email": Field().Optional().String(),
+		"Phone": Field().Optional().String(),
+	}.SuperRefine(func(ctx Context, r *Refinement) {
+		c := ctx.(Contact)
+		if c.Email == nil && c.Phone == nil {
+			r.AddError("Email", errors.New("Email is required when Phone is not set"))
+			r.AddError("Phone", &ValidationError{Code: CodeRequired, Message: "Phone is required when Email is not set"})
+		}
+		if c.Address.City == "" {
+			r.AddError("Address.City", errors.New("City is required"))
+		}
+		r.AddError("", nil)
+	})
+
+	t.Run("passes", func(t *testing.T) {
+		phone := "555-1234"
+		if err := schema.Parse(Contact{Phone: &phone, Address: Address{City: "Rome"}}); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+	})
+
+	t.Run("reports the first error", func(t *testing.T) {
+		var vErr *ValidationError
+		err := schema.Parse(Contact{})
+		if !errors.As(err, &vErr) || vErr.Path != "Email" || vErr.Code != CodeCustom {
+			t.Errorf("expected a %s error for Email, got %v", CodeCustom, err)
+		}
+	})
+
+	t.Run("reports every error", func(t *testing.T) {
+		errs, ok := schema.ParseAll(Contact{}).(ValidationErrors)
+		if !ok || len(errs) != 3 {
+			t.Fatalf("expected 3 errors, got %v", errs)
+		}
+
+		expected := []struct{ path, key, code string }{
+			{"Email", "Email", CodeCustom},
+			{"Phone", "Phone", CodeRequired},
+			{"Address.City", "City", CodeCustom},
+		}
+		for i, e := range expected {
+			var vErr *ValidationError
+			if !errors.As(errs[i], &vErr) || vErr.Path != e.path || vErr.Key != e.key || vErr.Code != e.code {
+				t.Errorf("expected a %s error for %s, got %v", e.code, e.path, errs[i])
+			}
+		}
+	})
+
+	t.Run("nested schemas", func(t *testing.T) {
+		type User struct {
+			Contact *Contact
+		}
+
+		err := Schema{"Contact": Field().Schema(schema)}.Parse(User{Contact: &Contact{}})
+		var vErr *ValidationError
+		if !errors.As(err, &vErr) || vErr.Path != "Contact.Email" {
+			t.Errorf("expected an error for Contact.Email, got %v", err)
+		}
+	})
+}
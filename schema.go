@@ -1,31 +1,63 @@
 package corretto
 
 import (
-	"encoding/json"
 	"reflect"
+	"slices"
+	"sync"
 )
 
 type Schema map[string]validator
 
-// Schema checks if the field can be parsed by the provided schema
+// Schema checks if the field can be parsed by the provided schema, either a [Schema] or an [ObjectSchema]
 // Use it to validate nested structs
 //
 // NOTE: the field associated with the schema must be exported
 //
 //	type Parent struct {
 //		Son  *Son // Field.Schema() works fine
-//		daughter *Daughter // Field.Schema() will panic
+//		daughter *Daughter // Field.Schema() will return a [SchemaDefinitionError]
 //	}
-func (v *BaseValidator) Schema(s Schema) *BaseValidator {
-	v.validations = append(v.validations, func() error {
-		if !v.field.CanInterface() {
-			logger.Panicf("field `%v` must be exported to be validated", v.key)
+func (v *BaseValidator) Schema(s Shape) *BaseValidator {
+	o := s.object()
+	v.schema = &o
+	v.typeChecks = append(v.typeChecks, func(t reflect.Type, path string) error {
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
 		}
-		return s.Parse(v.field.Interface())
+		if t.Kind() == reflect.Interface || isDocument(t) {
+			return nil
+		}
+		return o.compile(t, path)
+	})
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if !e.field.CanInterface() {
+			return newSchemaDefinitionError(e.path, "field `%v` must be exported to be validated", e.key)
+		}
+		return o.parseField(e)
 	})
 	return v
 }
 
+// parseField runs the validations of the schema on the value of the field, the evaluation is the one of the field
+func (o ObjectSchema) parseField(e *evalContext) error {
+	if o.options.lazy != nil {
+		return o.parseLazy(e.field, e, func(inner ObjectSchema, e *evalContext) error {
+			return inner.parseField(e)
+		})
+	}
+
+	if e.field.Kind() == reflect.Struct {
+		// Validate the field itself rather than a copy, so that transformations are written back to the parent struct
+		fields, err := o.fieldsOf(e.field.Type(), e)
+		if err != nil {
+			return err
+		}
+		value := e.field.Interface()
+		return o.runChecks(value, e, parseFields(value, e.field, fields, e))
+	}
+	return o.parse(e.field.Interface(), e)
+}
+
 // Parse validates the struct fields based on the schema
 // It returns an error if any of the validations fail or nil if all validations pass
 //
@@ -45,63 +77,305 @@ func (v *BaseValidator) Schema(s Schema) *BaseValidator {
 //		err := schema.Parse(user) // ValidationError{Message: "Age must be at least 18"}
 //	 	// you can pass a reference too
 //		err := schema.Parse(&user) // ValidationError{Message: "Age must be at least 18"}
+//
+// The value can also be a map document such as a map[string]any decoded from JSON, in that case the keys of the schema
+// are the keys of the map, nested documents can be validated with [BaseValidator.Schema] and []any with [ArrayValidator.Of]
+//
+//	err := schema.Parse(map[string]any{"FirstName": "John", "Age": 17})
 func (s Schema) Parse(value any) error {
-	for key, validator := range s {
-		var t reflect.Type
-		var v reflect.Value
+	return s.object().Parse(value)
+}
+
+// ParseAll behaves the same as [Schema.Parse] but instead of stopping at the first error
+// it runs every validation of every field, nested schemas and array elements included.
+// It returns a [ValidationErrors] listing all the failures or nil if all validations pass
+//
+// Example:
+//
+//	err := schema.ParseAll(user)
+//	var errs corretto.ValidationErrors
+//	if errors.As(err, &errs) {
+//		for _, e := range errs {
+//			log.Println(e)
+//		}
+//	}
+func (s Schema) ParseAll(value any) error {
+	return s.object().ParseAll(value)
+}
+
+// parse runs the validations of the schema, stopping at the first error unless all errors are being collected
+// The fields are validated in the order they are declared in the struct (see [Schema.keys]), then the checks of the whole value run
+// The parent is the evaluation of the field holding the value, for the root struct it only carries the parse options
+func (o ObjectSchema) parse(value any, parent *evalContext) error {
+	if o.options.lazy != nil {
+		return o.parseLazy(reflect.ValueOf(value), parent, func(inner ObjectSchema, parent *evalContext) error {
+			return inner.parse(value, parent)
+		})
+	}
+	if v := reflect.Indirect(reflect.ValueOf(value)); v.IsValid() && isDocument(v.Type()) {
+		return o.runChecks(value, parent, o.parseDocument(value, v, parent))
+	}
+
+	t, v, err := structOf(value, parent.path)
+	if err != nil {
+		return err
+	}
+
+	fields, err := o.fieldsOf(t, parent)
+	if err != nil {
+		return err
+	}
+
+	return o.runChecks(value, parent, parseFields(value, v, fields, parent))
+}
 
-		// Check if the value is a pointer to a struct or a struct value
-		if reflect.TypeOf(value).Kind() == reflect.Ptr {
-			t = reflect.TypeOf(value).Elem()
-			v = reflect.ValueOf(value).Elem()
-		} else {
-			t = reflect.TypeOf(value)
-			v = reflect.ValueOf(value)
+// runChecks runs the checks of the whole value (see [schemaOptions]) once its fields are validated,
+// err is the result of the validation of the fields
+func (o ObjectSchema) runChecks(value any, parent *evalContext, err error) error {
+	checks := o.options.checks
+	if len(checks) == 0 {
+		return err
+	}
+
+	var errs ValidationErrors
+	for _, check := range checks {
+		if err != nil {
+			if !parent.all || isSchemaDefinitionError(err) {
+				return err
+			}
+			errs.add(err)
 		}
+		err = check(value, parent)
+	}
+	if err != nil {
+		if !parent.all || isSchemaDefinitionError(err) {
+			return err
+		}
+		errs.add(err)
+	}
 
+	return errs.err()
+}
+
+// fieldsOf returns the fields of the struct type validated by the schema,
+// the ones resolved by [CompiledSchema] are used if the evaluation has them
+func (o ObjectSchema) fieldsOf(t reflect.Type, e *evalContext) ([]schemaField, error) {
+	if fields, ok := e.fields[fieldsKeyOf(o.schema, t)]; ok {
+		return fields, nil
+	}
+	return o.schema.fields(t, e.path)
+}
+
+// schemaField is a key of the schema resolved to the field of the struct it validates
+type schemaField struct {
+	key       string
+	index     []int // The index sequence of the field in the struct, see [reflect.Value.FieldByIndex]
+	validator validator
+}
+
+// fields resolves the keys of the schema to the fields of the struct type, sorted as in [Schema.keys]
+func (s Schema) fields(t reflect.Type, path string) ([]schemaField, error) {
+	sf := structFieldsOf(t)
+	keys := s.keys(t)
+	fields := make([]schemaField, 0, len(keys))
+	for _, key := range keys {
 		// Check if the field exists in the struct
-		if _, ok := t.FieldByName(key); !ok {
-			logger.Panicf("field %s not found in struct %s", key, t.Name())
+		index, ok := sf.index[key]
+		if !ok {
+			return nil, newSchemaDefinitionError(joinPath(path, key), "field %s not found in struct %s", key, t.Name())
 		}
+		fields = append(fields, schemaField{key: key, index: index, validator: s[key]})
+	}
 
-		baseValidator := validator.getBaseValidator()
-		baseValidator.field = v.FieldByName(key)
-		baseValidator.ctx = value
-		baseValidator.key = key
-		// If no custom field name is provided, use the struct field name
-		if baseValidator.fieldName == "" {
-			baseValidator.fieldName = key
+	return fields, nil
+}
+
+// structFields holds the fields of a struct type, see [structFieldsOf]
+type structFields struct {
+	names []string         // The names of the visible fields in the order they are declared, see [reflect.VisibleFields]
+	index map[string][]int // The index sequence of the fields that can be accessed by name, see [reflect.Type.FieldByName]
+}
+
+// structFieldsCache maps the struct types to their *structFields
+var structFieldsCache sync.Map
+
+// structFieldsOf returns the fields of the struct type t, they are resolved only the first time the type is seen
+func structFieldsOf(t reflect.Type) *structFields {
+	if sf, ok := structFieldsCache.Load(t); ok {
+		return sf.(*structFields)
+	}
+
+	sf := &structFields{index: make(map[string][]int)}
+	seen := make(map[string]bool)
+	for _, f := range reflect.VisibleFields(t) {
+		if seen[f.Name] {
+			continue
+		}
+		seen[f.Name] = true
+		sf.names = append(sf.names, f.Name)
+		// Fields with the same name at the same depth are ambiguous, so they can't be accessed by name
+		if byName, ok := t.FieldByName(f.Name); ok {
+			sf.index[f.Name] = byName.Index
 		}
+	}
+
+	actual, _ := structFieldsCache.LoadOrStore(t, sf)
+	return actual.(*structFields)
+}
+
+// parseDocument runs the validations of the schema on the map document v, after checking its unknown keys
+func (o ObjectSchema) parseDocument(value any, v reflect.Value, parent *evalContext) error {
+	var errs ValidationErrors
+	if err := o.checkUnknownKeys(v, parent); err != nil {
+		if !parent.all {
+			return err
+		}
+		errs.add(err)
+	}
 
-		// If any of the validations fail, return the error
-		if err := baseValidator.check(); err != nil {
+	if err := parseFields(value, v, o.schema.documentFields(), parent); err != nil {
+		if !parent.all || isSchemaDefinitionError(err) {
 			return err
 		}
+		errs.add(err)
 	}
 
-	return nil
+	return errs.err()
 }
 
-// Unmarshal parses the JSON data into the struct and validates the fields based on the schema
-func (s Schema) Unmarshal(data []byte, v any) error {
-	if err := json.Unmarshal(data, &v); err != nil {
-		return err
+// documentFields returns the keys of the schema as fields of a map document, sorted alphabetically
+func (s Schema) documentFields() []schemaField {
+	keys := s.keys(nil)
+	fields := make([]schemaField, 0, len(keys))
+	for _, key := range keys {
+		fields = append(fields, schemaField{key: key, validator: s[key]})
 	}
+	return fields
+}
 
-	return s.Parse(v)
+// isDocument reports whether values of type t are map documents, i.e. maps with string keys such as map[string]any
+func isDocument(t reflect.Type) bool {
+	return t.Kind() == reflect.Map && t.Key().Kind() == reflect.String
 }
 
-// MustParse behaves the same as [Schema.Parse] but panics if any of the validations fail
-func (s Schema) MustParse(value any) {
-	err := s.Parse(value)
+// structOf returns the type and value of the struct, dereferencing it if it's a pointer
+func structOf(value any, path string) (reflect.Type, reflect.Value, error) {
+	if value == nil {
+		return nil, reflect.Value{}, newSchemaDefinitionError(path, "schema can only be used with structs, got nil")
+	}
+
+	var t reflect.Type
+	var v reflect.Value
+
+	// Check if the value is a pointer to a struct or a struct value
+	if reflect.TypeOf(value).Kind() == reflect.Ptr {
+		if reflect.ValueOf(value).IsNil() {
+			return nil, reflect.Value{}, newSchemaDefinitionError(path, "schema can't be used with a nil %T", value)
+		}
+		t = reflect.TypeOf(value).Elem()
+		v = reflect.ValueOf(value).Elem()
+	} else {
+		t = reflect.TypeOf(value)
+		v = reflect.ValueOf(value)
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil, reflect.Value{}, newSchemaDefinitionError(path, "schema can only be used with structs, got %v", t.Kind())
+	}
+
+	return t, v, nil
+}
+
+// parseFields runs the validations of the fields of the struct (or map document) value v
+func parseFields(value any, v reflect.Value, fields []schemaField, parent *evalContext) error {
+	var errs ValidationErrors
+	for _, f := range fields {
+		baseValidator := f.validator.getBaseValidator()
+		e := &evalContext{
+			ctx:       value,
+			field:     fieldOf(v, f),
+			key:       f.key,
+			fieldName: baseValidator.fieldName,
+			path:      joinPath(parent.path, f.key),
+			all:       parent.all,
+			depth:     parent.depth,
+			visiting:  parent.visiting,
+			writeBack: parent.writeBack,
+			trial:     parent.trial,
+			fields:    parent.fields,
+		}
+		if v.Kind() == reflect.Map {
+			e.entry = mapEntry{v, reflect.ValueOf(f.key).Convert(v.Type().Key())}
+		}
+		// If no custom field name is provided, use the struct field name
+		if e.fieldName == "" {
+			e.fieldName = f.key
+		}
+
+		if err := baseValidator.check(e); err != nil {
+			// If any of the validations fail, return the error
+			if !parent.all || isSchemaDefinitionError(err) {
+				return err
+			}
+			errs.add(err)
+		}
+	}
+
+	return errs.err()
+}
+
+// fieldOf returns the field of the struct (or the value of the map document) validated by the schema field
+// A key missing from a map document is returned as the zero [reflect.Value]
+func fieldOf(v reflect.Value, f schemaField) reflect.Value {
+	if v.Kind() == reflect.Map {
+		return unwrap(v.MapIndex(reflect.ValueOf(f.key).Convert(v.Type().Key())))
+	}
+	return promotedField(v, f.index)
+}
+
+// promotedField returns the field of the struct v at the index sequence
+// A field promoted through a nil embedded pointer doesn't exist, so it's returned as a nil pointer to its type
+func promotedField(v reflect.Value, index []int) reflect.Value {
+	field, err := v.FieldByIndexErr(index)
 	if err != nil {
-		panic(err)
+		return reflect.Zero(reflect.PointerTo(v.Type().FieldByIndex(index).Type))
+	}
+	return field
+}
+
+// keys returns the keys of the schema sorted in the same order as the fields of the struct type,
+// this way the errors are always reported in the same order.
+// Keys that are not fields of the struct are placed at the end in alphabetical order, if t is nil all of them are
+func (s Schema) keys(t reflect.Type) []string {
+	keys := make([]string, 0, len(s))
+	var sf *structFields
+	if t != nil {
+		sf = structFieldsOf(t)
+		for _, name := range sf.names {
+			if _, ok := s[name]; ok {
+				keys = append(keys, name)
+			}
+		}
+	}
+	if len(keys) == len(s) {
+		return keys
+	}
+
+	rest := make([]string, 0, len(s)-len(keys))
+	for key := range s {
+		if sf == nil || !slices.Contains(sf.names, key) {
+			rest = append(rest, key)
+		}
 	}
+	slices.Sort(rest)
+
+	return append(keys, rest...)
 }
 
-// MustUnmarshal behaves the same as [Schema.Unmarshal] but panics if any of the validations fail or if the JSON data cannot be parsed
-func (s Schema) MustUnmarshal(data []byte, v any) {
-	err := s.Unmarshal(data, v)
+// MustParse behaves the same as [Schema.Parse] but panics if any of the validations fail
+// or if the schema doesn't match the value (see [SchemaDefinitionError])
+func (s Schema) MustParse(value any) {
+	err := s.Parse(value)
 	if err != nil {
 		panic(err)
 	}
@@ -109,8 +383,36 @@ func (s Schema) MustUnmarshal(data []byte, v any) {
 
 // Concat adds the fields from another [Schema] to the current schema
 // If the field already exists, it will be overwritten
+//
+// Deprecated: Concat modifies the schema in place, use [Schema.Extend] which returns a new schema instead
 func (s Schema) Concat(other Schema) {
 	for key, value := range other {
 		s[key] = value
 	}
 }
+
+// name returns the name of the field displayed in the messages, at the root of the document it's "document"
+func (e *evalContext) name() string {
+	if e.fieldName == "" {
+		return documentName
+	}
+	return e.fieldName
+}
+
+// schemaError fills the field information of an error of the whole value validated by the schema,
+// the evaluation is the one of the field holding the value
+func (e *evalContext) schemaError(err *ValidationError) *ValidationError {
+	err.Key = e.key
+	err.Field = e.name()
+	err.Path = e.path
+	err.Value = valueOf(e.field)
+	return err
+}
+
+// joinPath appends the key to the path of the parent struct
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -1,29 +1,110 @@
 package corretto
 
 import (
-	"io"
-	"os"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 )
 
 func TestParse(t *testing.T) {
-	t.Run("panics if schema has unknown field", func(t *testing.T) {
-		// Discard panic logs since they are expected
-		logger.SetOutput(io.Discard)
+	t.Run("returns SchemaDefinitionError if schema has unknown field", func(t *testing.T) {
+		schema := Schema{
+			"UnexistingField": Field().String().NonEmpty(),
+		}
 
-		defer func() {
-			if r := recover(); r == nil {
-				t.Errorf("Parse() should have panicked")
+		for _, parse := range []func(any) error{schema.Parse, schema.ParseAll} {
+			err := parse(&struct{ Name string }{Name: "John"})
+
+			var defErr *SchemaDefinitionError
+			if !errors.As(err, &defErr) {
+				t.Fatalf("expected a SchemaDefinitionError, got %v", err)
+			}
+			if defErr.Path != "UnexistingField" {
+				t.Errorf("expected path UnexistingField, got %s", defErr.Path)
 			}
+		}
+	})
+
+	t.Run("returns SchemaDefinitionError if value is not a struct", func(t *testing.T) {
+		schema := Schema{
+			"Name": Field().String().NonEmpty(),
+		}
+
+		var defErr *SchemaDefinitionError
+		if err := schema.Parse("John"); !errors.As(err, &defErr) {
+			t.Errorf("expected a SchemaDefinitionError, got %v", err)
+		}
+		if err := schema.Parse(nil); !errors.As(err, &defErr) {
+			t.Errorf("expected a SchemaDefinitionError, got %v", err)
+		}
+	})
 
-			logger.SetOutput(os.Stderr)
+	t.Run("returns SchemaDefinitionError for nil pointers", func(t *testing.T) {
+		type User struct{ Name string }
+		schema := Schema{
+			"Name": Field().String().NonEmpty(),
+		}
+
+		var defErr *SchemaDefinitionError
+		if err := schema.Parse((*User)(nil)); !errors.As(err, &defErr) {
+			t.Errorf("expected a SchemaDefinitionError, got %v", err)
+		}
+
+		compiled, err := CompileFor[User](schema)
+		if err != nil {
+			t.Fatalf("Compile() should have returned nil, got %v", err)
+		}
+		if err := compiled.Parse((*User)(nil)); !errors.As(err, &defErr) {
+			t.Errorf("expected a SchemaDefinitionError from the compiled schema, got %v", err)
+		}
+	})
+
+	t.Run("PanicOnDefinitionError panics only on definition errors", func(t *testing.T) {
+		type User struct{ Name string }
+		schema := Schema{
+			"Name": Field().String().NonEmpty(),
+		}.PanicOnDefinitionError()
+
+		var vErr *ValidationError
+		if err := schema.ParseAll(User{}); !errors.As(err, &vErr) {
+			t.Errorf("expected a ValidationError, got %v", err)
+		}
+
+		compiled, err := CompileFor[User](schema)
+		if err != nil {
+			t.Fatalf("Compile() should have returned nil, got %v", err)
+		}
+		for name, parse := range map[string]func(any) error{"Parse": schema.Parse, "CompiledSchema.Parse": compiled.Parse} {
+			func() {
+				defer func() {
+					if _, ok := recover().(*SchemaDefinitionError); !ok {
+						t.Errorf("%s should have panicked with a SchemaDefinitionError", name)
+					}
+				}()
+				_ = parse((*User)(nil))
+			}()
+		}
+	})
+
+	t.Run("MustParse panics if schema has unknown field", func(t *testing.T) {
+		defer func() {
+			r := recover()
+			if _, ok := r.(*SchemaDefinitionError); !ok {
+				t.Errorf("MustParse() should have panicked with a SchemaDefinitionError, got %v", r)
+			}
 		}()
 
 		schema := Schema{
 			"UnexistingField": Field().String().NonEmpty(),
 		}
 
-		_ = schema.Parse(&struct{ Name string }{Name: "John"})
+		schema.MustParse(&struct{ Name string }{Name: "John"})
 	})
 
 	t.Run("accepts both pointers and values", func(t *testing.T) {
@@ -42,57 +123,6 @@ func TestParse(t *testing.T) {
 	})
 }
 
-func TestUnmarshal(t *testing.T) {
-	tests := []struct {
-		name        string
-		bytes       []byte
-		dst         any
-		expectError bool
-	}{
-		{
-			name:  "valid json and valid data",
-			bytes: []byte(`{"Name": "John", "Age": 30}`),
-			dst: &struct {
-				Name string
-				Age  int
-			}{},
-			expectError: false,
-		},
-		{
-			name:  "valid json and invalid data",
-			bytes: []byte(`{"Name": "John", "Age": 12}`),
-			dst: &struct {
-				Name string
-				Age  int
-			}{},
-			expectError: true,
-		},
-		{
-			name:  "invalid json",
-			bytes: []byte(`{"Name": "John", "Age": `),
-			dst: &struct {
-				Name string
-				Age  int
-			}{},
-			expectError: true,
-		},
-	}
-
-	schema := Schema{
-		"Name": Field().String().NonEmpty(),
-		"Age":  Field().Number().Min(18),
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := schema.Unmarshal(tt.bytes, tt.dst)
-			if tt.expectError && err == nil {
-				t.Errorf("Unmarshal() should have returned an error")
-			}
-		})
-	}
-}
-
 func TestConcat(t *testing.T) {
 	s := &struct{ Field1, Field2 string }{Field1: "John"}
 	schema := Schema{
@@ -151,18 +181,7 @@ func TestNestedSchemas(t *testing.T) {
 		}
 	})
 
-	t.Run("panics if field is not exported", func(t *testing.T) {
-		// Discard panic logs since they are expected
-		logger.SetOutput(io.Discard)
-
-		defer func() {
-			if r := recover(); r == nil {
-				t.Errorf("Parse() should have panicked")
-			}
-
-			logger.SetOutput(os.Stderr)
-		}()
-
+	t.Run("returns SchemaDefinitionError if field is not exported", func(t *testing.T) {
 		type Nested struct {
 			NestedField1 int
 		}
@@ -188,6 +207,541 @@ func TestNestedSchemas(t *testing.T) {
 			},
 		}
 
-		_ = s1.Parse(v)
+		err := s1.Parse(v)
+
+		var defErr *SchemaDefinitionError
+		if !errors.As(err, &defErr) {
+			t.Errorf("expected a SchemaDefinitionError, got %v", err)
+		}
+	})
+}
+
+func TestParseAll(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	type User struct {
+		Name    string
+		Age     int
+		Hobbies []string
+		Address Address
+	}
+
+	schema := Schema{
+		"Name":    Field().String().MinLength(3).Matches("^[a-z]+$"),
+		"Age":     Field().Number().Min(18),
+		"Hobbies": Field().Array().Of(Field().String().NonEmpty()),
+		"Address": Field().Schema(Schema{"City": Field().String().NonEmpty()}),
+	}
+
+	t.Run("collects every failure", func(t *testing.T) {
+		u := User{Name: "J1", Age: 12, Hobbies: []string{"", "reading", ""}}
+
+		err := schema.ParseAll(u)
+		var errs ValidationErrors
+		if !errors.As(err, &errs) {
+			t.Fatalf("ParseAll() should have returned ValidationErrors, got %T", err)
+		}
+
+		// Name (2), Age (1), Hobbies (2), Address.City (1)
+		if len(errs) != 6 {
+			t.Errorf("expected 6 errors, got %d: %v", len(errs), errs)
+		}
+	})
+
+	t.Run("returns nil if valid", func(t *testing.T) {
+		u := User{Name: "john", Age: 30, Hobbies: []string{"reading"}, Address: Address{City: "Rome"}}
+
+		if err := schema.ParseAll(u); err != nil {
+			t.Errorf("ParseAll() should have returned nil, got %v", err)
+		}
+	})
+
+	t.Run("stops at type errors", func(t *testing.T) {
+		s := Schema{
+			"Field1": Field().String().MinLength(3).NonEmpty(),
+		}
+
+		err := s.ParseAll(struct{ Field1 int }{Field1: 1})
+		var errs ValidationErrors
+		if !errors.As(err, &errs) || len(errs) != 1 {
+			t.Errorf("expected only the type error, got %v", err)
+		}
+	})
+
+	t.Run("Parse stops at the first error", func(t *testing.T) {
+		u := User{Name: "J1", Age: 12}
+
+		err := schema.Parse(u)
+		var errs ValidationErrors
+		if err == nil || errors.As(err, &errs) {
+			t.Errorf("Parse() should have returned a single error, got %v", err)
+		}
+	})
+}
+
+func TestErrorPath(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	type User struct {
+		Name    string
+		Address Address
+	}
+
+	type Team struct {
+		Users []User
+	}
+
+	addressSchema := Schema{
+		"City": Field().String().NonEmpty(),
+	}
+	userSchema := Schema{
+		"Name":    Field().String().NonEmpty(),
+		"Address": Field().Schema(addressSchema),
+	}
+	teamSchema := Schema{
+		"Users": Field().Array().Of(Field().Schema(userSchema)),
+	}
+
+	team := Team{
+		Users: []User{
+			{Name: "John", Address: Address{City: "Rome"}},
+			{Name: "", Address: Address{City: ""}},
+		},
+	}
+
+	err := teamSchema.ParseAll(team)
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("ParseAll() should have returned ValidationErrors, got %T", err)
+	}
+
+	paths := []string{}
+	for _, e := range errs {
+		var vErr *ValidationError
+		if errors.As(e, &vErr) {
+			paths = append(paths, vErr.Path)
+		}
+	}
+	slices.Sort(paths)
+
+	expected := []string{"Users[1].Address.City", "Users[1].Name"}
+	if !slices.Equal(paths, expected) {
+		t.Errorf("expected paths %v, got %v", expected, paths)
+	}
+}
+
+func TestConcurrentParse(t *testing.T) {
+	// Run with `go test -race` to detect data races between the goroutines
+	type Address struct {
+		City string
+	}
+
+	type User struct {
+		Name      string
+		Addresses []Address
+	}
+
+	schema := Schema{
+		"Name": Field().String().MinLength(3),
+		"Addresses": Field().Array().Of(Field().Schema(Schema{
+			"City": Field().String().NonEmpty(),
+		})),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// Odd goroutines validate an invalid user, even ones a valid user
+			u := User{Name: "John", Addresses: []Address{{City: "Rome"}}}
+			if i%2 == 1 {
+				u.Addresses = append(u.Addresses, Address{City: ""})
+			}
+
+			err := schema.ParseAll(&u)
+			if i%2 == 0 {
+				if err != nil {
+					t.Errorf("ParseAll() should have returned nil, got %v", err)
+				}
+				return
+			}
+
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) || vErr.Path != "Addresses[1].City" {
+				t.Errorf("ParseAll() should have returned an error for Addresses[1].City, got %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestParseOrder(t *testing.T) {
+	type Embedded struct {
+		Field3 string
+	}
+
+	type Struct struct {
+		Field2 string
+		Field1 string
+		Embedded
+		Field4 string
+	}
+
+	var calls []string
+	record := func(ctx Context, value string) error {
+		calls = append(calls, value)
+		return fmt.Errorf("%s is invalid", value)
+	}
+
+	schema := Schema{
+		"Field1": Field().String().Test(record),
+		"Field2": Field().String().Test(record),
+		"Field3": Field().String().Test(record),
+		"Field4": Field().String().Test(record),
+	}
+
+	v := Struct{Field2: "a", Field1: "b", Embedded: Embedded{Field3: "c"}, Field4: "d"}
+	expected := []string{"a", "b", "c", "d"}
+
+	// Run it multiple times since map iteration order is random
+	for i := 0; i < 20; i++ {
+		calls = nil
+		err := schema.ParseAll(v)
+
+		if !slices.Equal(calls, expected) {
+			t.Fatalf("expected fields to be validated in order %v, got %v", expected, calls)
+		}
+		if err.Error() != "a is invalid\nb is invalid\nc is invalid\nd is invalid" {
+			t.Fatalf("expected errors in struct order, got %q", err.Error())
+		}
+		if err := schema.Parse(v); err.Error() != "a is invalid" {
+			t.Fatalf("Parse() should have returned the error of the first field, got %q", err.Error())
+		}
+	}
+}
+
+func TestStructFieldsCache(t *testing.T) {
+	type A struct{ ID, Name string }
+	type B struct{ ID string }
+	type Struct struct {
+		A
+		B
+		Email string
+	}
+
+	sf := structFieldsOf(reflect.TypeFor[Struct]())
+	if structFieldsOf(reflect.TypeFor[Struct]()) != sf {
+		t.Errorf("the fields should have been resolved only once")
+	}
+	// ID is ambiguous, so it's not visible
+	if !slices.Equal(sf.names, []string{"A", "Name", "B", "Email"}) {
+		t.Errorf("unexpected names %v", sf.names)
+	}
+
+	var defErr *SchemaDefinitionError
+	if err := (Schema{"ID": Field().String()}).Parse(Struct{}); !errors.As(err, &defErr) {
+		t.Errorf("expected a SchemaDefinitionError for the ambiguous field, got %v", err)
+	}
+}
+
+func TestNilEmbeddedPointer(t *testing.T) {
+	type Base struct {
+		ID int
+	}
+	type Entity struct {
+		*Base
+		Name string
+	}
+
+	optional := Schema{"ID": Field().Optional().Number().Positive(), "Name": Field().String()}
+	required := Schema{"ID": Field().Number().Positive(), "Name": Field().String()}
+
+	compiledOptional, err := CompileFor[Entity](optional)
+	if err != nil {
+		t.Fatalf("Compile() should have returned nil, got %v", err)
+	}
+	compiledRequired, err := CompileFor[Entity](required)
+	if err != nil {
+		t.Fatalf("Compile() should have returned nil, got %v", err)
+	}
+
+	v := Entity{Name: "x"}
+	for name, parse := range map[string]func(any) error{"Parse": optional.Parse, "CompiledSchema.Parse": compiledOptional.Parse} {
+		if err := parse(v); err != nil {
+			t.Errorf("%s should have returned nil for an optional field, got %v", name, err)
+		}
+	}
+	for name, parse := range map[string]func(any) error{"Parse": required.Parse, "CompiledSchema.Parse": compiledRequired.Parse} {
+		var vErr *ValidationError
+		if err := parse(v); !errors.As(err, &vErr) || vErr.Code != CodeRequired || vErr.Path != "ID" {
+			t.Errorf("%s should have returned a %s error for ID, got %v", name, CodeRequired, err)
+		}
+	}
+
+	if err := required.Parse(Entity{Base: &Base{ID: 1}, Name: "x"}); err != nil {
+		t.Errorf("Parse() should have returned nil, got %v", err)
+	}
+}
+
+func TestParseDocument(t *testing.T) {
+	schema := Schema{
+		"name": Field().String().MinLength(3),
+		"age":  Field().Number().Min(18),
+		"tags": Field().Optional().Array().Of(Field().String().NonEmpty()),
+		"address": Field().Schema(Schema{
+			"city": Field().String().NonEmpty(),
+			"zip":  Field().Nullable().String().Length(5),
+		}),
+	}
+
+	tests := []struct {
+		name         string
+		document     string
+		expectedPath string
+	}{
+		{"valid document", `{"name": "John", "age": 30, "tags": ["a"], "address": {"city": "Rome", "zip": "00100"}}`, ""},
+		{"invalid value", `{"name": "Jo", "age": 30, "address": {"city": "Rome", "zip": null}}`, "name"},
+		{"json number", `{"name": "John", "age": 17, "address": {"city": "Rome", "zip": null}}`, "age"},
+		{"not a number", `{"name": "John", "age": "30", "address": {"city": "Rome", "zip": null}}`, "age"},
+		{"missing required key", `{"name": "John", "address": {"city": "Rome", "zip": null}}`, "age"},
+		{"null required value", `{"name": "John", "age": null, "address": {"city": "Rome", "zip": null}}`, "age"},
+		{"missing optional key", `{"name": "John", "age": 30, "address": {"city": "Rome", "zip": null}}`, ""},
+		{"missing nullable key", `{"name": "John", "age": 30, "address": {"city": "Rome"}}`, "address.zip"},
+		{"invalid nested value", `{"name": "John", "age": 30, "address": {"city": "", "zip": null}}`, "address.city"},
+		{"invalid array element", `{"name": "John", "age": 30, "tags": ["a", ""], "address": {"city": "Rome", "zip": null}}`, "tags[1]"},
+		{"nested document is not an object", `{"name": "John", "age": 30, "address": "Rome"}`, "address"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dec := json.NewDecoder(strings.NewReader(tt.document))
+			dec.UseNumber()
+			var doc map[string]any
+			if err := dec.Decode(&doc); err != nil {
+				t.Fatal(err)
+			}
+
+			err := schema.Parse(doc)
+			if tt.expectedPath == "" {
+				if err != nil {
+					t.Errorf("Parse() should have returned nil, got %v", err)
+				}
+				return
+			}
+
+			var vErr *ValidationError
+			var defErr *SchemaDefinitionError
+			switch {
+			case errors.As(err, &vErr):
+				if vErr.Path != tt.expectedPath {
+					t.Errorf("expected an error for %s, got %q for %s", tt.expectedPath, err, vErr.Path)
+				}
+			case errors.As(err, &defErr):
+				if defErr.Path != tt.expectedPath {
+					t.Errorf("expected an error for %s, got %q for %s", tt.expectedPath, err, defErr.Path)
+				}
+			default:
+				t.Errorf("expected an error for %s, got %v", tt.expectedPath, err)
+			}
+		})
+	}
+
+	t.Run("float64 numbers", func(t *testing.T) {
+		var doc map[string]any
+		if err := json.Unmarshal([]byte(`{"name": "John", "age": 17.5, "address": {"city": "Rome", "zip": null}}`), &doc); err != nil {
+			t.Fatal(err)
+		}
+
+		var vErr *ValidationError
+		if err := schema.Parse(doc); !errors.As(err, &vErr) || vErr.Code != CodeNumberMin {
+			t.Errorf("expected a %s error, got %v", CodeNumberMin, err)
+		}
+	})
+
+	t.Run("validates keys in alphabetical order", func(t *testing.T) {
+		doc := map[string]any{"name": "", "age": 0, "address": map[string]any{}}
+		err := schema.ParseAll(&doc)
+		if err == nil || err.Error() != "city is required\nzip is required\nage must be at least 18\nname must be at least 3 characters long" {
+			t.Errorf("unexpected errors %q", err)
+		}
+	})
+}
+
+func TestUnknownKeys(t *testing.T) {
+	address := Schema{
+		"city": Field().String().NonEmpty(),
+	}
+	schema := Schema{
+		"name":    Field().String().NonEmpty(),
+		"address": Field().Optional().Schema(address),
+	}
+
+	t.Run("passthrough is the default", func(t *testing.T) {
+		for _, s := range []Shape{schema, schema.Passthrough()} {
+			doc := map[string]any{"name": "John", "admin": true}
+			if err := s.object().Parse(doc); err != nil {
+				t.Errorf("Parse() should have returned nil, got %v", err)
+			}
+			if _, ok := doc["admin"]; !ok {
+				t.Errorf("Parse() should have kept the unknown keys")
+			}
+		}
+	})
+
+	t.Run("strict reports the unknown keys", func(t *testing.T) {
+		err := schema.Strict().Parse(map[string]any{"name": "John", "role": "admin", "admin": true})
+
+		var vErr *ValidationError
+		if !errors.As(err, &vErr) || vErr.Code != CodeUnknownKeys {
+			t.Fatalf("expected a %s error, got %v", CodeUnknownKeys, err)
+		}
+		if !slices.Equal(vErr.Params["keys"].([]string), []string{"admin", "role"}) {
+			t.Errorf("expected the keys [admin role], got %v", vErr.Params["keys"])
+		}
+		if err.Error() != "document has unknown keys [admin role]" {
+			t.Errorf("unexpected message %q", err.Error())
+		}
+	})
+
+	t.Run("strict with nested schemas", func(t *testing.T) {
+		s := Schema{
+			"name":    Field().String().NonEmpty(),
+			"address": Field().Schema(address.Strict("%v can't have %v")),
+		}
+
+		err := s.ParseAll(map[string]any{"name": "", "address": map[string]any{"city": "Rome", "zip": "00100"}})
+		var errs ValidationErrors
+		if !errors.As(err, &errs) || len(errs) != 2 {
+			t.Fatalf("expected 2 errors, got %v", err)
+		}
+
+		var vErr *ValidationError
+		if !errors.As(errs[0], &vErr) || vErr.Path != "address" || vErr.Message != "address can't have [zip]" {
+			t.Errorf("expected the unknown keys of address, got %v", errs[0])
+		}
+	})
+
+	t.Run("strip removes the unknown keys", func(t *testing.T) {
+		doc := map[string]any{"name": "John", "admin": true, "address": map[string]any{"city": "Rome", "zip": "00100"}}
+		s := Schema{
+			"name":    Field().String().NonEmpty(),
+			"address": Field().Schema(address.Strip()),
+		}.Strip()
+
+		if err := s.Parse(doc); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+		if _, ok := doc["admin"]; ok {
+			t.Errorf("Parse() should have removed admin, got %v", doc)
+		}
+		if _, ok := doc["address"].(map[string]any)["zip"]; ok {
+			t.Errorf("Parse() should have removed address.zip, got %v", doc)
+		}
+	})
+
+	t.Run("strict doesn't change the original schema", func(t *testing.T) {
+		_ = schema.Strict()
+		if err := schema.Parse(map[string]any{"name": "John", "admin": true}); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+	})
+
+	t.Run("strict with Unmarshal", func(t *testing.T) {
+		type Address struct {
+			City string `json:"city"`
+		}
+		type User struct {
+			Name      string    `json:"name"`
+			Addresses []Address `json:"addresses"`
+			Nickname  string    `json:"nickname"`
+		}
+
+		s := Schema{
+			"Name":      Field().String().NonEmpty(),
+			"Addresses": Field().Array().Of(Field().Schema(Schema{"City": Field().String()}.Strict())),
+		}
+
+		tests := []struct {
+			name         string
+			schema       ObjectSchema
+			data         string
+			expectedPath string
+			expectedKeys []string
+		}{
+			{"known keys", s.Strict(), `{"name": "John", "addresses": [{"city": "Rome"}]}`, "", nil},
+			{"undeclared field", s.Strict(), `{"name": "John", "nickname": "Jo"}`, "", []string{"nickname"}},
+			{"unknown field", s.Strict(), `{"name": "John", "admin": true, "role": "admin"}`, "", []string{"admin", "role"}},
+			{"nested unknown field", s.object(), `{"name": "John", "admin": true, "addresses": [{"city": "Rome"}, {"zip": "00100"}]}`, "addresses[1]", []string{"zip"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := tt.schema.Unmarshal([]byte(tt.data), &User{})
+				if tt.expectedKeys == nil {
+					if err != nil {
+						t.Errorf("Unmarshal() should have returned nil, got %v", err)
+					}
+					return
+				}
+
+				var vErr *ValidationError
+				if !errors.As(err, &vErr) || vErr.Code != CodeUnknownKeys {
+					t.Fatalf("expected a %s error, got %v", CodeUnknownKeys, err)
+				}
+				if vErr.Path != tt.expectedPath || !slices.Equal(vErr.Params["keys"].([]string), tt.expectedKeys) {
+					t.Errorf("expected %v at %q, got %v at %q", tt.expectedKeys, tt.expectedPath, vErr.Params["keys"], vErr.Path)
+				}
+			})
+		}
+
+		t.Run("reports the unknown keys of map documents once", func(t *testing.T) {
+			type Profile struct {
+				Meta map[string]any `json:"meta"`
+			}
+			meta := Schema{"role": Field().String()}.Strict()
+
+			errs := map[string]error{
+				"map":        meta.Unmarshal([]byte(`{"role": "admin", "x": 1}`), &map[string]any{}),
+				"nested map": Schema{"Meta": Field().Schema(meta)}.Unmarshal([]byte(`{"meta": {"x": 1}}`), &Profile{}),
+			}
+			for name, err := range errs {
+				var vErr *ValidationError
+				if _, ok := err.(ValidationErrors); ok || !errors.As(err, &vErr) || vErr.Code != CodeUnknownKeys {
+					t.Errorf("%s: expected a single %s error, got %v", name, CodeUnknownKeys, err)
+				}
+			}
+		})
+
+		t.Run("reports the errors of the fields too", func(t *testing.T) {
+			data := []byte(`{"name": "", "admin": true, "addresses": [{"zip": "00100"}]}`)
+			for name, unmarshal := range map[string]func() error{
+				"Unmarshal": func() error { return s.Strict().Unmarshal(data, &User{}) },
+				"Decode":    func() error { return s.Strict().Decode(bytes.NewReader(data), &User{}) },
+			} {
+				errs, ok := unmarshal().(ValidationErrors)
+				if !ok || len(errs) != 3 {
+					t.Fatalf("%s: expected 3 errors, got %v", name, errs)
+				}
+
+				expected := []struct{ path, code string }{
+					{"addresses[0]", CodeUnknownKeys},
+					{"", CodeUnknownKeys},
+					{"Name", CodeStringNonEmpty},
+				}
+				for i, e := range expected {
+					var vErr *ValidationError
+					if !errors.As(errs[i], &vErr) || vErr.Path != e.path || vErr.Code != e.code {
+						t.Errorf("%s: expected a %s error at %q, got %v", name, e.code, e.path, errs[i])
+					}
+				}
+			}
+		})
 	})
 }
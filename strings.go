@@ -5,6 +5,8 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 const (
@@ -20,6 +22,25 @@ const (
 	notAValidURLErrorMsg    = "%v is not a valid URL"
 )
 
+// Codes of the string validations, see [ValidationError]
+const (
+	CodeNotAString       = "string.type"
+	CodeStringNonEmpty   = "string.nonempty"
+	CodeStringMinLength  = "string.min_length"
+	CodeStringMaxLength  = "string.max_length"
+	CodeStringLength     = "string.length"
+	CodeStringMatches    = "string.matches"
+	CodeStringOneOf      = "string.one_of"
+	CodeStringIncludes   = "string.includes"
+	CodeStringStartsWith = "string.starts_with"
+	CodeStringEndsWith   = "string.ends_with"
+	CodeStringUrl        = "string.url"
+	CodeStringEmail      = "string.email"
+	CodeStringUuid       = "string.uuid"
+	CodeStringCuid       = "string.cuid"
+	CodeStringHexColor   = "string.hex_color"
+)
+
 const (
 	emailRegexString    = `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
 	uuidRegexString     = `^[0-9a-fA-F]{8}\b-[0-9a-fA-F]{4}\b-[0-9a-fA-F]{4}\b-[0-9a-fA-F]{4}\b-[0-9a-fA-F]{12}$`
@@ -32,6 +53,7 @@ var (
 	uuidRegex     = regexp.MustCompile(uuidRegexString)
 	cuidRegex     = regexp.MustCompile(cuidRegexString)
 	hexColorRegex = regexp.MustCompile(hexColorRegexString)
+	spacesRegex   = regexp.MustCompile(`\s+`)
 )
 
 type StringValidator struct {
@@ -42,9 +64,10 @@ type StringValidator struct {
 func (v *BaseValidator) String(msg ...string) *StringValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if v.field.Kind() != reflect.String {
-			return newValidationError(notAStringErrorMsg, cmsg, v.fieldName)
+	v.typeChecks = append(v.typeChecks, kindCheck("String()", reflect.String))
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Kind() != reflect.String {
+			return abort(newValidationError(CodeNotAString, nil, notAStringErrorMsg, cmsg, e.fieldName))
 		}
 		return nil
 	})
@@ -56,9 +79,9 @@ func (v *BaseValidator) String(msg ...string) *StringValidator {
 func (v *StringValidator) NonEmpty(msg ...string) *StringValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if strings.TrimSpace(v.field.String()) == "" {
-			return newValidationError(nonEmptyErrorMsg, cmsg, v.fieldName)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if strings.TrimSpace(e.field.String()) == "" {
+			return newValidationError(CodeStringNonEmpty, nil, nonEmptyErrorMsg, cmsg, e.fieldName)
 		}
 		return nil
 	})
@@ -69,9 +92,9 @@ func (v *StringValidator) NonEmpty(msg ...string) *StringValidator {
 func (v *StringValidator) MinLength(min int, msg ...string) *StringValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if len(v.field.String()) < min {
-			return newValidationError(stringMinLengthErrorMsg, cmsg, v.fieldName, min)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if len(e.field.String()) < min {
+			return newValidationError(CodeStringMinLength, map[string]any{"min": min}, stringMinLengthErrorMsg, cmsg, e.fieldName, min)
 		}
 		return nil
 	})
@@ -82,9 +105,9 @@ func (v *StringValidator) MinLength(min int, msg ...string) *StringValidator {
 func (v *StringValidator) MaxLength(max int, msg ...string) *StringValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if len(v.field.String()) > max {
-			return newValidationError(stringMaxLengthErrorMsg, cmsg, v.fieldName, max)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if len(e.field.String()) > max {
+			return newValidationError(CodeStringMaxLength, map[string]any{"max": max}, stringMaxLengthErrorMsg, cmsg, e.fieldName, max)
 		}
 		return nil
 	})
@@ -97,9 +120,9 @@ func (v *StringValidator) MaxLength(max int, msg ...string) *StringValidator {
 func (v *StringValidator) Length(l int, msg ...string) *StringValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if len(v.field.String()) != l {
-			return newValidationError(stringLengthErrorMsg, cmsg, v.fieldName, l)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if len(e.field.String()) != l {
+			return newValidationError(CodeStringLength, map[string]any{"length": l}, stringLengthErrorMsg, cmsg, e.fieldName, l)
 		}
 		return nil
 	})
@@ -113,8 +136,8 @@ func (v *StringValidator) Length(l int, msg ...string) *StringValidator {
 //
 //	func(ctx corretto.Context, value string) error
 func (v *StringValidator) Test(f CustomValidationFunc[string]) *StringValidator {
-	v.validations = append(v.validations, func() error {
-		return f(v.ctx, v.field.String())
+	v.validations = append(v.validations, func(e *evalContext) error {
+		return customError(f(e.ctx, e.field.String()))
 	})
 	return v
 }
@@ -125,12 +148,14 @@ func (v *StringValidator) Test(f CustomValidationFunc[string]) *StringValidator
 //
 // it uses the [regexp] package to match the regex, if the regex is invalid, it will panic
 func (v *StringValidator) Matches(regex string, msg ...string) *StringValidator {
-	cmsg := optional(msg)
-	r := regexp.MustCompile(regex)
+	return v.matches(regexp.MustCompile(regex), CodeStringMatches, optional(msg))
+}
 
-	v.validations = append(v.validations, func() error {
-		if v.field.String() != "" && !r.MatchString(v.field.String()) {
-			return newValidationError(matchesErrorMsg, cmsg, v.fieldName)
+// matches checks if the field matches the regex, reporting the provided code on failure
+func (v *StringValidator) matches(r *regexp.Regexp, code string, cmsg string) *StringValidator {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.String() != "" && !r.MatchString(e.field.String()) {
+			return newValidationError(code, map[string]any{"pattern": r.String()}, matchesErrorMsg, cmsg, e.fieldName)
 		}
 		return nil
 	})
@@ -143,9 +168,9 @@ func (v *StringValidator) Matches(regex string, msg ...string) *StringValidator
 func (v *StringValidator) OneOf(allowed []string, msg ...string) *StringValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if !oneOf(v.field.String(), allowed) {
-			return newValidationError(oneOfErrorMsg, cmsg, v.fieldName, allowed)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if !oneOf(e.field.String(), allowed) {
+			return newValidationError(CodeStringOneOf, map[string]any{"allowed": allowed}, oneOfErrorMsg, cmsg, e.fieldName, allowed)
 		}
 		return nil
 	})
@@ -161,9 +186,9 @@ func (v *StringValidator) OneOf(allowed []string, msg ...string) *StringValidato
 func (v *StringValidator) Includes(substr string, msg ...string) *StringValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if !strings.Contains(v.field.String(), substr) {
-			return newValidationError(mustIncludeErrorMsg, cmsg, v.fieldName, substr)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if !strings.Contains(e.field.String(), substr) {
+			return newValidationError(CodeStringIncludes, map[string]any{"substr": substr}, mustIncludeErrorMsg, cmsg, e.fieldName, substr)
 		}
 		return nil
 	})
@@ -177,9 +202,9 @@ func (v *StringValidator) Includes(substr string, msg ...string) *StringValidato
 func (v *StringValidator) StartsWith(prefix string, msg ...string) *StringValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if !strings.HasPrefix(v.field.String(), prefix) {
-			return newValidationError(mustStartWithErrorMsg, cmsg, v.fieldName, prefix)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if !strings.HasPrefix(e.field.String(), prefix) {
+			return newValidationError(CodeStringStartsWith, map[string]any{"prefix": prefix}, mustStartWithErrorMsg, cmsg, e.fieldName, prefix)
 		}
 		return nil
 	})
@@ -193,9 +218,9 @@ func (v *StringValidator) StartsWith(prefix string, msg ...string) *StringValida
 func (v *StringValidator) EndsWith(suffix string, msg ...string) *StringValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		if !strings.HasSuffix(v.field.String(), suffix) {
-			return newValidationError(mustEndWithErrorMsg, cmsg, v.fieldName, suffix)
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if !strings.HasSuffix(e.field.String(), suffix) {
+			return newValidationError(CodeStringEndsWith, map[string]any{"suffix": suffix}, mustEndWithErrorMsg, cmsg, e.fieldName, suffix)
 		}
 		return nil
 	})
@@ -207,10 +232,10 @@ func (v *StringValidator) EndsWith(suffix string, msg ...string) *StringValidato
 func (v *StringValidator) Url(msg ...string) *StringValidator {
 	cmsg := optional(msg)
 
-	v.validations = append(v.validations, func() error {
-		_, err := url.ParseRequestURI(v.field.String())
+	v.validations = append(v.validations, func(e *evalContext) error {
+		_, err := url.ParseRequestURI(e.field.String())
 		if err != nil {
-			return newValidationError(notAValidURLErrorMsg, cmsg, v.fieldName)
+			return newValidationError(CodeStringUrl, nil, notAValidURLErrorMsg, cmsg, e.fieldName)
 		}
 
 		return nil
@@ -223,14 +248,14 @@ func (v *StringValidator) Url(msg ...string) *StringValidator {
 //
 // if the string is empty, it will not return error, use [StringValidator.NonEmpty] to check for empty strings
 func (v *StringValidator) Email(msg ...string) *StringValidator {
-	return v.Matches(emailRegex.String(), msg...)
+	return v.matches(emailRegex, CodeStringEmail, optional(msg))
 }
 
 // Uuid checks if the field is a valid UUID v4 format
 //
 // if the string is empty, it will not return error, use [StringValidator.NonEmpty] to check for empty strings
 func (v *StringValidator) Uuid(msg ...string) *StringValidator {
-	return v.Matches(uuidRegex.String(), msg...)
+	return v.matches(uuidRegex, CodeStringUuid, optional(msg))
 }
 
 // Cuid checks if the field is a valid CUID format (Collision-resistant ids)
@@ -238,7 +263,7 @@ func (v *StringValidator) Uuid(msg ...string) *StringValidator {
 //
 // if the string is empty, it will not return error, use [StringValidator.NonEmpty] to check for empty strings
 func (v *StringValidator) Cuid(msg ...string) *StringValidator {
-	return v.Matches(cuidRegex.String(), msg...)
+	return v.matches(cuidRegex, CodeStringCuid, optional(msg))
 }
 
 // HexColor checks if the field is a valid HEX color format
@@ -249,5 +274,65 @@ func (v *StringValidator) Cuid(msg ...string) *StringValidator {
 //
 // if the string is empty, it will not return error, use [StringValidator.NonEmpty] to check for empty strings
 func (v *StringValidator) HexColor(msg ...string) *StringValidator {
-	return v.Matches(hexColorRegex.String(), msg...)
+	return v.matches(hexColorRegex, CodeStringHexColor, optional(msg))
+}
+
+// transform replaces the value of the field with the result of f, see [evalContext.set]
+func (v *StringValidator) transform(f func(string) string) *StringValidator {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		e.set(reflect.ValueOf(f(e.field.String())))
+		return nil
+	})
+	return v
+}
+
+// Trim removes the leading and trailing white spaces from the field
+//
+// When a pointer is passed to Parse the field of the struct is updated, the validations following it see the trimmed value either way
+//
+//	"Email": corretto.Field().String().Trim().ToLower().Email(),
+func (v *StringValidator) Trim() *StringValidator {
+	return v.transform(strings.TrimSpace)
+}
+
+// ToLower converts the field to lower case, see [StringValidator.Trim] for how the field is updated
+func (v *StringValidator) ToLower() *StringValidator {
+	return v.transform(strings.ToLower)
+}
+
+// ToUpper converts the field to upper case, see [StringValidator.Trim] for how the field is updated
+func (v *StringValidator) ToUpper() *StringValidator {
+	return v.transform(strings.ToUpper)
+}
+
+// NormalizeUnicode converts the field to the provided Unicode normalization form, NFC by default,
+// see [StringValidator.Trim] for how the field is updated
+//
+//	"Username": corretto.Field().String().NormalizeUnicode(norm.NFKC),
+func (v *StringValidator) NormalizeUnicode(form ...norm.Form) *StringValidator {
+	f := optional(form)
+	return v.transform(f.String)
+}
+
+// CollapseSpaces replaces every sequence of white spaces in the field with a single space,
+// see [StringValidator.Trim] for how the field is updated
+func (v *StringValidator) CollapseSpaces() *StringValidator {
+	return v.transform(func(s string) string {
+		return spacesRegex.ReplaceAllString(s, " ")
+	})
+}
+
+// Default sets the field to the provided value when it's empty or a nil pointer, before running the validations
+//
+// When a pointer is passed to Parse the field of the struct is updated, the validations see the default value either way
+//
+//	"Sort": corretto.Field().String().Default("asc").OneOf([]string{"asc", "desc"}),
+func (v *StringValidator) Default(value string) *StringValidator {
+	return v.DefaultFunc(func() string { return value })
+}
+
+// DefaultFunc behaves the same as [StringValidator.Default] but the value is computed by f every time it's needed
+func (v *StringValidator) DefaultFunc(f func() string) *StringValidator {
+	v.setDefault(func() any { return f() }, reflect.TypeFor[string]())
+	return v
 }
@@ -3,6 +3,8 @@ package corretto
 import (
 	"fmt"
 	"testing"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 func TestString(t *testing.T) {
@@ -351,3 +353,135 @@ func TestStringUrl(t *testing.T) {
 		})
 	}
 }
+
+func TestStringTransform(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator
+		value     string
+		expected  string
+	}{
+		{"trim", Field().String().Trim(), "  john@doe.com \n", "john@doe.com"},
+		{"to lower", Field().String().ToLower(), "John@Doe.COM", "john@doe.com"},
+		{"to upper", Field().String().ToUpper(), "it", "IT"},
+		{"normalize unicode", Field().String().NormalizeUnicode(), "Cafe\u0301", "Caf\u00e9"},
+		{"normalize unicode with form", Field().String().NormalizeUnicode(norm.NFD), "Caf\u00e9", "Cafe\u0301"},
+		{"collapse spaces", Field().String().CollapseSpaces(), "John  \t Doe", "John Doe"},
+		{"chained", Field().String().Trim().CollapseSpaces().ToLower(), " John   DOE ", "john doe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &struct{ Field1 string }{Field1: tt.value}
+
+			if err := (Schema{"Field1": tt.validator}).Parse(v); err != nil {
+				t.Fatalf("Parse() should have returned nil, got %v", err)
+			}
+			if v.Field1 != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, v.Field1)
+			}
+		})
+	}
+
+	t.Run("following rules see the transformed value", func(t *testing.T) {
+		schema := Schema{
+			"Email": Field().String().Trim().ToLower().Email().OneOf([]string{"john@doe.com"}),
+		}
+
+		// A value is not updated, but the rules still run on the transformed string
+		v := struct{ Email string }{Email: " John@Doe.com "}
+		if err := schema.Parse(v); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+		if v.Email != " John@Doe.com " {
+			t.Errorf("Parse() should not have changed a value, got %q", v.Email)
+		}
+	})
+
+	t.Run("updates nested structs and array elements", func(t *testing.T) {
+		type Address struct {
+			City string
+		}
+		type User struct {
+			Address   Address
+			Previous  *Address
+			Nicknames []string
+		}
+
+		city := Schema{"City": Field().String().Trim()}
+		schema := Schema{
+			"Address":   Field().Schema(city),
+			"Previous":  Field().Schema(city),
+			"Nicknames": Field().Array().Of(Field().String().ToUpper()),
+		}
+
+		u := &User{Address: Address{City: " Rome "}, Previous: &Address{City: " Milan "}, Nicknames: []string{"jd"}}
+		if err := schema.Parse(u); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+		if u.Address.City != "Rome" || u.Previous.City != "Milan" || u.Nicknames[0] != "JD" {
+			t.Errorf("Parse() should have updated the nested fields, got %+v", u)
+		}
+	})
+
+	t.Run("updates only when a pointer is passed", func(t *testing.T) {
+		type User struct {
+			Hobbies []string
+			Labels  map[string]string
+		}
+
+		schema := Schema{
+			"Hobbies": Field().Array().Of(Field().String().Trim().ToUpper()),
+			"Labels":  Field().Map().Values(Field().String().Trim()),
+		}
+
+		u := User{Hobbies: []string{" chess "}, Labels: map[string]string{"env": " prod "}}
+		if err := schema.Parse(u); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+		if u.Hobbies[0] != " chess " || u.Labels["env"] != " prod " {
+			t.Errorf("Parse() should not have changed a value, got %+v", u)
+		}
+
+		if err := schema.Parse(&u); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+		if u.Hobbies[0] != "CHESS" || u.Labels["env"] != "prod" {
+			t.Errorf("Parse() should have updated the elements and the map values, got %+v", u)
+		}
+	})
+
+	t.Run("updates map documents", func(t *testing.T) {
+		schema := Schema{
+			"name": Field().String().Trim(),
+			"role": Field().Optional().String().Default("user"),
+		}
+
+		doc := map[string]any{"name": " John "}
+		if err := schema.Parse(doc); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+		if doc["name"] != " John " || len(doc) != 1 {
+			t.Errorf("Parse() should not have changed the document, got %v", doc)
+		}
+
+		if err := schema.Parse(&doc); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+		if doc["name"] != "John" || doc["role"] != "user" {
+			t.Errorf("Parse() should have updated the document, got %v", doc)
+		}
+	})
+
+	t.Run("keeps the type of the field", func(t *testing.T) {
+		type Code string
+		v := &struct{ Field1 Code }{Field1: " it "}
+
+		if err := (Schema{"Field1": Field().String().Trim().ToUpper()}).Parse(v); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+		if v.Field1 != "IT" {
+			t.Errorf("expected IT, got %q", v.Field1)
+		}
+	})
+}
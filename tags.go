@@ -0,0 +1,444 @@
+package corretto
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	tagName    = "corretto"     // The struct tag holding the rules of the field
+	msgTagName = "corretto_msg" // The struct tag holding the custom messages of the rules
+)
+
+// The primitive validators that can be declared in a tag
+const (
+	stringTag = "string"
+	numberTag = "number"
+	boolTag   = "bool"
+	arrayTag  = "array"
+)
+
+// tagRule is a single rule of a tag, e.g. `min=3`
+type tagRule struct {
+	name  string
+	param string
+}
+
+// SchemaOf builds a [Schema] from the struct tags of T, see [SchemaFromTags]
+//
+//	schema, err := corretto.SchemaOf[User]()
+func SchemaOf[T any]() (Schema, error) {
+	return newTagSchemas().build(reflect.TypeFor[T](), "")
+}
+
+// SchemaFromTags builds a [Schema] from the struct tags of the struct v (or the struct it points to)
+//
+// The rules are declared in the `corretto` tag, separated by commas. The first one can be the primitive validator
+// (string, number, bool or array), if it's omitted it's inferred from the type of the field.
+// Rules with a parameter use the `rule=param` syntax, lists of values are separated by spaces.
+// The `name` rule sets the name displayed in the error messages, like [Field] does,
+// while `optional`, `nullable` and `required` work like [BaseValidator.Optional] and the others.
+// Untagged pointers to nested structs are optional.
+// Recursive structs (e.g. trees) reference their own schema through [Lazy].
+//
+//	type User struct {
+//		FirstName string   `corretto:"string,name=Name,min=3,max=20"`
+//		Email     string   `corretto:"nonempty,email"`
+//		Nickname  *string  `corretto:"optional,min=3"`
+//		Status    string   `corretto:"oneof=active inactive"`
+//		Age       int      `corretto:"number,min=18" corretto_msg:"min=you must be an adult"`
+//		Hobbies   []string `corretto:"array,max=5,dive,nonempty"`
+//		Address   Address  // nested structs are validated with their own tags
+//		Internal  string   `corretto:"-"` // skipped
+//	}
+//
+// Custom messages are declared in the `corretto_msg` tag as `rule=message` pairs separated by semicolons,
+// the message of the type check uses the primitive name as rule (e.g. `string=%v must be text`).
+//
+// Rules after `dive` are applied to the elements of an array, nested structs and arrays of structs
+// are validated with a schema built from their own tags.
+//
+// Available rules:
+//
+//   - string: nonempty, min, max, len, matches, oneof, includes, startswith, endswith, url, email, uuid, cuid, hexcolor
+//   - number: nonzero, positive, negative, nonnegative, nonpositive, min, max, oneof, multipleof, finite
+//   - array: nonempty, min, max, len
+//
+// NOTE: since rules are separated by commas, the regex of `matches` can't contain any.
+//
+// It returns a [SchemaDefinitionError] if a tag contains an unknown rule or an invalid parameter
+func SchemaFromTags(v any) (Schema, error) {
+	return newTagSchemas().build(reflect.TypeOf(v), "")
+}
+
+// tagSchemas holds the schemas built from the tags of the struct types, so that each type is built only once
+type tagSchemas struct {
+	built    map[reflect.Type]Schema
+	building map[reflect.Type]bool // The types whose schema is being built, i.e. the ones a recursive type can reference
+}
+
+func newTagSchemas() *tagSchemas {
+	return &tagSchemas{built: map[reflect.Type]Schema{}, building: map[reflect.Type]bool{}}
+}
+
+// nested returns the schema of the struct type of a field, a [Lazy] one if the type is recursive
+func (b *tagSchemas) nested(t reflect.Type, path string) (Shape, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if b.building[t] {
+		// The schema is filled once the type is built, which happens before it's used
+		s := b.built[t]
+		return Lazy(func() Schema { return s }), nil
+	}
+	return b.build(t, path)
+}
+
+// build builds the schema of the struct type from its tags
+func (b *tagSchemas) build(t reflect.Type, path string) (Schema, error) {
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, newSchemaDefinitionError(path, "schema can only be used with structs, got %v", t)
+	}
+	if s, ok := b.built[t]; ok {
+		return s, nil
+	}
+
+	s := Schema{}
+	b.built[t], b.building[t] = s, true
+	defer delete(b.building, t)
+
+	for _, f := range reflect.VisibleFields(t) {
+		// The fields of embedded structs are promoted, so they are handled on their own,
+		// while unexported fields can't be validated
+		if f.Anonymous || !f.IsExported() {
+			continue
+		}
+
+		tag, ok := f.Tag.Lookup(tagName)
+		if tag == "-" {
+			continue
+		}
+
+		fieldPath := joinPath(path, f.Name)
+		v, err := b.validator(f.Type, parseTag(tag), parseMsgTag(f.Tag.Get(msgTagName)), ok, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			s[f.Name] = v
+		}
+	}
+
+	return s, nil
+}
+
+// parseTag splits the tag into its rules
+func parseTag(tag string) []tagRule {
+	var rules []tagRule
+	for _, r := range strings.Split(tag, ",") {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		name, param, _ := strings.Cut(r, "=")
+		rules = append(rules, tagRule{name: name, param: param})
+	}
+	return rules
+}
+
+// parseMsgTag splits the messages tag into a map of rule names to messages
+func parseMsgTag(tag string) map[string]string {
+	msgs := map[string]string{}
+	for _, m := range strings.Split(tag, ";") {
+		name, msg, ok := strings.Cut(m, "=")
+		if ok {
+			msgs[strings.TrimSpace(name)] = msg
+		}
+	}
+	return msgs
+}
+
+// validator builds the validator of a field of type t from its rules.
+// It returns nil if the field doesn't need to be validated
+func (b *tagSchemas) validator(t reflect.Type, rules []tagRule, msgs map[string]string, tagged bool, path string) (validator, error) {
+	if !tagged {
+		return b.untagged(t, path)
+	}
+
+	// The rules after `dive` are the ones of the array elements
+	var elemRules []tagRule
+	if i := slices.IndexFunc(rules, func(r tagRule) bool { return r.name == "dive" }); i >= 0 {
+		rules, elemRules = rules[:i], rules[i+1:]
+	}
+
+	var name string
+	if i := slices.IndexFunc(rules, func(r tagRule) bool { return r.name == "name" }); i >= 0 {
+		name = rules[i].param
+		rules = slices.Delete(slices.Clone(rules), i, i+1)
+	}
+
+	bv := Field(name)
+	rules = slices.DeleteFunc(slices.Clone(rules), func(r tagRule) bool {
+		switch r.name {
+		case "optional":
+			bv.Optional()
+		case "nullable":
+			bv.Nullable()
+		case "required":
+			bv.Required(msgs[r.name])
+		default:
+			return false
+		}
+		return true
+	})
+
+	primitive := primitiveOf(t)
+	if len(rules) > 0 && slices.Contains([]string{stringTag, numberTag, boolTag, arrayTag}, rules[0].name) {
+		primitive, rules = rules[0].name, rules[1:]
+	}
+
+	switch primitive {
+	case stringTag:
+		return bv, stringFromTag(bv.String(msgs[stringTag]), rules, msgs, path)
+	case numberTag:
+		return bv, numberFromTag(bv.Number(msgs[numberTag]), rules, msgs, path)
+	case boolTag:
+		bv.Bool(msgs[boolTag])
+		return bv, unknownRules(rules, boolTag, path)
+	case arrayTag:
+		av := bv.Array(msgs[arrayTag])
+		if err := arrayFromTag(av, rules, msgs, path); err != nil {
+			return nil, err
+		}
+
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() == reflect.Slice {
+			elemPath := fmt.Sprintf(arrayElementsPath, path)
+			elem, err := b.validator(t.Elem(), elemRules, map[string]string{}, len(elemRules) > 0, elemPath)
+			if err != nil {
+				return nil, err
+			}
+			if elem != nil {
+				av.Of(elem)
+			}
+		}
+		return bv, nil
+	}
+
+	// Nested structs are validated with the schema built from their tags
+	if isStruct(t) {
+		if err := unknownRules(rules, "struct", path); err != nil {
+			return nil, err
+		}
+
+		nested, err := b.nested(t, path)
+		if err != nil {
+			return nil, err
+		}
+		return bv.Schema(nested), nil
+	}
+
+	return nil, newSchemaDefinitionError(path, "can't infer the validator for field %s of type %v, declare it in the tag", path, t)
+}
+
+// untagged builds the validator of a field without tag,
+// which is validated only if it holds structs (or arrays of structs) with tagged fields.
+// Since they are not tagged, nil pointers to structs are allowed
+func (b *tagSchemas) untagged(t reflect.Type, path string) (validator, error) {
+	switch {
+	case isStruct(t):
+		nested, err := b.nested(t, path)
+		if err != nil {
+			return nil, err
+		}
+		if s, ok := nested.(Schema); ok && len(s) == 0 {
+			return nil, nil
+		}
+		return Field().Optional().Schema(nested), nil
+	case t.Kind() == reflect.Slice:
+		elem, err := b.untagged(t.Elem(), fmt.Sprintf(arrayElementsPath, path))
+		if err != nil || elem == nil {
+			return nil, err
+		}
+		return Field().Array().Of(elem), nil
+	default:
+		return nil, nil
+	}
+}
+
+// isStruct checks if the type is a struct or a pointer to a struct
+func isStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+// primitiveOf infers the primitive validator from the type of the field
+func primitiveOf(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return stringTag
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return numberTag
+	case reflect.Bool:
+		return boolTag
+	case reflect.Slice:
+		return arrayTag
+	default:
+		return ""
+	}
+}
+
+func stringFromTag(v *StringValidator, rules []tagRule, msgs map[string]string, path string) error {
+	for _, r := range rules {
+		msg := msgs[r.name]
+		switch r.name {
+		case "nonempty":
+			v.NonEmpty(msg)
+		case "min", "max", "len":
+			n, err := intParam(r, path)
+			if err != nil {
+				return err
+			}
+			switch r.name {
+			case "min":
+				v.MinLength(n, msg)
+			case "max":
+				v.MaxLength(n, msg)
+			default:
+				v.Length(n, msg)
+			}
+		case "matches":
+			if _, err := regexp.Compile(r.param); err != nil {
+				return newSchemaDefinitionError(path, "invalid regex for rule %s: %v", r.name, err)
+			}
+			v.Matches(r.param, msg)
+		case "oneof":
+			v.OneOf(strings.Fields(r.param), msg)
+		case "includes":
+			v.Includes(r.param, msg)
+		case "startswith":
+			v.StartsWith(r.param, msg)
+		case "endswith":
+			v.EndsWith(r.param, msg)
+		case "url":
+			v.Url(msg)
+		case "email":
+			v.Email(msg)
+		case "uuid":
+			v.Uuid(msg)
+		case "cuid":
+			v.Cuid(msg)
+		case "hexcolor":
+			v.HexColor(msg)
+		default:
+			return unknownRules([]tagRule{r}, stringTag, path)
+		}
+	}
+	return nil
+}
+
+func numberFromTag(v *NumberValidator, rules []tagRule, msgs map[string]string, path string) error {
+	for _, r := range rules {
+		msg := msgs[r.name]
+		switch r.name {
+		case "nonzero":
+			v.NonZero(msg)
+		case "positive":
+			v.Positive(msg)
+		case "negative":
+			v.Negative(msg)
+		case "nonnegative":
+			v.NonNegative(msg)
+		case "nonpositive":
+			v.NonPositive(msg)
+		case "finite":
+			v.Finite(msg)
+		case "min", "max", "multipleof":
+			n, err := intParam(r, path)
+			if err != nil {
+				return err
+			}
+			switch r.name {
+			case "min":
+				v.Min(n, msg)
+			case "max":
+				v.Max(n, msg)
+			default:
+				v.MultipleOf(n, msg)
+			}
+		case "oneof":
+			var allowed []int
+			for _, p := range strings.Fields(r.param) {
+				n, err := intParam(tagRule{name: r.name, param: p}, path)
+				if err != nil {
+					return err
+				}
+				allowed = append(allowed, n)
+			}
+			v.OneOf(allowed, msg)
+		default:
+			return unknownRules([]tagRule{r}, numberTag, path)
+		}
+	}
+	return nil
+}
+
+func arrayFromTag(v *ArrayValidator, rules []tagRule, msgs map[string]string, path string) error {
+	for _, r := range rules {
+		msg := msgs[r.name]
+		switch r.name {
+		case "nonempty":
+			v.NonEmpty(msg)
+		case "min", "max", "len":
+			n, err := intParam(r, path)
+			if err != nil {
+				return err
+			}
+			switch r.name {
+			case "min":
+				v.MinLength(n, msg)
+			case "max":
+				v.MaxLength(n, msg)
+			default:
+				v.Length(n, msg)
+			}
+		default:
+			return unknownRules([]tagRule{r}, arrayTag, path)
+		}
+	}
+	return nil
+}
+
+// intParam parses the parameter of the rule as an integer
+func intParam(r tagRule, path string) (int, error) {
+	n, err := strconv.Atoi(r.param)
+	if err != nil {
+		return 0, newSchemaDefinitionError(path, "rule %s requires an integer parameter, got %q", r.name, r.param)
+	}
+	return n, nil
+}
+
+// unknownRules returns an error if there is any rule left, since the primitive doesn't support it
+func unknownRules(rules []tagRule, primitive string, path string) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	return newSchemaDefinitionError(path, "unknown rule %s for %s fields", rules[0].name, primitive)
+}
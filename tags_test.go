@@ -0,0 +1,174 @@
+package corretto
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSchemaFromTags(t *testing.T) {
+	type Address struct {
+		City string `corretto:"nonempty"`
+		Zip  string
+	}
+
+	type User struct {
+		FirstName string    `corretto:"string,name=Name,min=3,max=20"`
+		Email     string    `corretto:"nonempty,email" corretto_msg:"email=%v is not an email"`
+		Status    string    `corretto:"oneof=active inactive"`
+		Age       int       `corretto:"number,min=18" corretto_msg:"min=you must be an adult"`
+		Hobbies   []string  `corretto:"array,max=2,dive,nonempty"`
+		Address   Address   // validated with its own tags
+		Addresses []Address // validated with its own tags
+		Internal  string    `corretto:"-"`
+		Untagged  string
+		Nickname  *string  `corretto:"optional,min=3"`
+		Backup    *Address // optional since it's not tagged
+		address   Address  // skipped since it's unexported
+	}
+
+	schema, err := SchemaOf[User]()
+	if err != nil {
+		t.Fatalf("SchemaOf() should have returned nil, got %v", err)
+	}
+
+	t.Run("builds validators only for tagged fields", func(t *testing.T) {
+		keys := []string{}
+		for key := range schema {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		expected := []string{"Address", "Addresses", "Age", "Backup", "Email", "FirstName", "Hobbies", "Nickname", "Status"}
+		if !slices.Equal(keys, expected) {
+			t.Errorf("expected keys %v, got %v", expected, keys)
+		}
+	})
+
+	valid := User{
+		FirstName: "John",
+		Email:     "john@doe.com",
+		Status:    "active",
+		Age:       30,
+		Hobbies:   []string{"reading"},
+		Address:   Address{City: "Rome"},
+		Addresses: []Address{{City: "Milan"}},
+	}
+
+	tests := []struct {
+		name        string
+		update      func(u *User)
+		expectedMsg string
+	}{
+		{"valid user", func(u *User) {}, ""},
+		{"display name", func(u *User) { u.FirstName = "Jo" }, "Name must be at least 3 characters long"},
+		{"custom message with placeholder", func(u *User) { u.Email = "john" }, "Email is not an email"},
+		{"list parameter", func(u *User) { u.Status = "banned" }, "Status must be one of [active inactive]"},
+		{"custom message", func(u *User) { u.Age = 12 }, "you must be an adult"},
+		{"array elements", func(u *User) { u.Hobbies = []string{""} }, "Hobbies's elements cannot be empty"},
+		{"nested struct", func(u *User) { u.Address.City = "" }, "City cannot be empty"},
+		{"nested struct in array", func(u *User) { u.Addresses[0].City = "" }, "City cannot be empty"},
+		{"optional pointer", func(u *User) { u.Nickname = new(string) }, "Nickname must be at least 3 characters long"},
+		{"untagged nested pointer", func(u *User) { u.Backup = &Address{} }, "City cannot be empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := valid
+			u.Addresses = slices.Clone(valid.Addresses)
+			tt.update(&u)
+
+			err := schema.Parse(u)
+			if tt.expectedMsg == "" {
+				if err != nil {
+					t.Errorf("Parse() should have returned nil, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.expectedMsg {
+				t.Errorf("expected: %s, got: %v", tt.expectedMsg, err)
+			}
+		})
+	}
+}
+
+func TestSchemaFromTagsRecursive(t *testing.T) {
+	type Category struct {
+		Name     string      `corretto:"nonempty"`
+		Children []Category  // validated with the schema of Category
+		Parent   *Category   `corretto:"optional"`
+		Related  []*Category `corretto:"array,max=2,dive,required"`
+	}
+
+	schema, err := SchemaOf[Category]()
+	if err != nil {
+		t.Fatalf("SchemaOf() should have returned nil, got %v", err)
+	}
+	if _, err := CompileFor[Category](schema); err != nil {
+		t.Errorf("Compile() should have returned nil, got %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		value        Category
+		expectedPath string
+	}{
+		{"valid tree", Category{Name: "Root", Children: []Category{{Name: "Child", Children: []Category{{Name: "Leaf"}}}}}, ""},
+		{"nested child", Category{Name: "Root", Children: []Category{{Name: "Child", Children: []Category{{}}}}}, "Children[0].Children[0].Name"},
+		{"parent", Category{Name: "Root", Parent: &Category{}}, "Parent.Name"},
+		{"related", Category{Name: "Root", Related: []*Category{{Name: "Other"}, nil}}, "Related[1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Parse(tt.value)
+			if tt.expectedPath == "" {
+				if err != nil {
+					t.Errorf("Parse() should have returned nil, got %v", err)
+				}
+				return
+			}
+
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) || vErr.Path != tt.expectedPath {
+				t.Errorf("expected an error for %s, got %v", tt.expectedPath, err)
+			}
+		})
+	}
+}
+
+func TestSchemaFromTagsErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		path  string
+	}{
+		{"unknown rule", struct {
+			Name string `corretto:"string,unknown"`
+		}{}, "Name"},
+		{"invalid parameter", struct {
+			Age int `corretto:"min=eighteen"`
+		}{}, "Age"},
+		{"invalid regex", struct {
+			Name string `corretto:"matches=["`
+		}{}, "Name"},
+		{"rule of another primitive", struct {
+			Active bool `corretto:"nonempty"`
+		}{}, "Active"},
+		{"not a struct", "John", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SchemaFromTags(tt.value)
+
+			var defErr *SchemaDefinitionError
+			if !errors.As(err, &defErr) {
+				t.Fatalf("SchemaFromTags() should have returned a SchemaDefinitionError, got %v", err)
+			}
+			if defErr.Path != tt.path {
+				t.Errorf("expected path %s, got %s", tt.path, defErr.Path)
+			}
+		})
+	}
+}
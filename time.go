@@ -0,0 +1,232 @@
+package corretto
+
+import (
+	"reflect"
+	"time"
+)
+
+const (
+	notATimeErrorMsg     = "%v is not a time"
+	timeBeforeErrorMsg   = "%v must be before %v"
+	timeAfterErrorMsg    = "%v must be after %v"
+	timeBetweenErrorMsg  = "%v must be between %v and %v"
+	timeInPastErrorMsg   = "%v must be in the past"
+	timeInFutureErrorMsg = "%v must be in the future"
+	zeroTimeErrorMsg     = "%v is required"
+	timeWithinErrorMsg   = "%v must be within %v from now"
+	notADateTimeErrorMsg = "%v must be a date in the format %v"
+)
+
+// Codes of the time validations, see [ValidationError]
+const (
+	CodeNotATime     = "time.type"
+	CodeTimeBefore   = "time.before"
+	CodeTimeAfter    = "time.after"
+	CodeTimeBetween  = "time.between"
+	CodeTimeInPast   = "time.past"
+	CodeTimeInFuture = "time.future"
+	CodeTimeNotZero  = "time.non_zero"
+	CodeTimeWithin   = "time.within"
+)
+
+// CodeStringDateTime is the [ValidationError.Code] reported by [StringValidator.DateTime]
+const CodeStringDateTime = "string.datetime"
+
+var timeType = reflect.TypeFor[time.Time]()
+
+type TimeValidator struct {
+	*BaseValidator
+}
+
+// Time checks if the field is a [time.Time]
+//
+// NOTE: the field must be exported to be validated
+func (v *BaseValidator) Time(msg ...string) *TimeValidator {
+	cmsg := optional(msg)
+
+	v.typeChecks = append(v.typeChecks, func(t reflect.Type, path string) error {
+		if t != timeType {
+			return newSchemaDefinitionError(path, "Time() can't be used on field %s of type %v", path, t)
+		}
+		return nil
+	})
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.Type() != timeType {
+			return abort(newValidationError(CodeNotATime, nil, notATimeErrorMsg, cmsg, e.fieldName))
+		}
+		if !e.field.CanInterface() {
+			return newSchemaDefinitionError(e.path, "field `%v` must be exported to be validated", e.key)
+		}
+		return nil
+	})
+
+	return &TimeValidator{BaseValidator: v}
+}
+
+// Clock sets the function used to get the current time in [TimeValidator.InPast], [TimeValidator.InFuture]
+// and [TimeValidator.WithinDuration], by default it's [time.Now]
+//
+// Use it to make the validations deterministic in tests
+//
+//	corretto.Field().Time().Clock(func() time.Time { return fixedTime }).InPast()
+func (v *TimeValidator) Clock(now func() time.Time) *TimeValidator {
+	v.clock = now
+	return v
+}
+
+// now returns the current time according to the clock of the validator running on the field, see [TimeValidator.Clock]
+func (e *evalContext) now() time.Time {
+	if e.clock != nil {
+		return e.clock()
+	}
+	return time.Now()
+}
+
+// timeOf returns the time held by the field
+func timeOf(field reflect.Value) time.Time {
+	return field.Interface().(time.Time)
+}
+
+// NotZero checks if the field is not the zero time
+func (v *TimeValidator) NotZero(msg ...string) *TimeValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if timeOf(e.field).IsZero() {
+			return newValidationError(CodeTimeNotZero, nil, zeroTimeErrorMsg, cmsg, e.fieldName)
+		}
+		return nil
+	})
+	return v
+}
+
+// Before checks if the field is strictly before the provided time
+func (v *TimeValidator) Before(t time.Time, msg ...string) *TimeValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if !timeOf(e.field).Before(t) {
+			return newValidationError(CodeTimeBefore, map[string]any{"before": t}, timeBeforeErrorMsg, cmsg, e.fieldName, t.Format(time.RFC3339))
+		}
+		return nil
+	})
+	return v
+}
+
+// After checks if the field is strictly after the provided time
+func (v *TimeValidator) After(t time.Time, msg ...string) *TimeValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if !timeOf(e.field).After(t) {
+			return newValidationError(CodeTimeAfter, map[string]any{"after": t}, timeAfterErrorMsg, cmsg, e.fieldName, t.Format(time.RFC3339))
+		}
+		return nil
+	})
+	return v
+}
+
+// Between checks if the field is between the provided times, both included
+func (v *TimeValidator) Between(start time.Time, end time.Time, msg ...string) *TimeValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if t := timeOf(e.field); t.Before(start) || t.After(end) {
+			return newValidationError(CodeTimeBetween, map[string]any{"start": start, "end": end}, timeBetweenErrorMsg, cmsg, e.fieldName, start.Format(time.RFC3339), end.Format(time.RFC3339))
+		}
+		return nil
+	})
+	return v
+}
+
+// InPast checks if the field is strictly before the current time, see [TimeValidator.Clock]
+func (v *TimeValidator) InPast(msg ...string) *TimeValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if !timeOf(e.field).Before(e.now()) {
+			return newValidationError(CodeTimeInPast, nil, timeInPastErrorMsg, cmsg, e.fieldName)
+		}
+		return nil
+	})
+	return v
+}
+
+// InFuture checks if the field is strictly after the current time, see [TimeValidator.Clock]
+func (v *TimeValidator) InFuture(msg ...string) *TimeValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if !timeOf(e.field).After(e.now()) {
+			return newValidationError(CodeTimeInFuture, nil, timeInFutureErrorMsg, cmsg, e.fieldName)
+		}
+		return nil
+	})
+	return v
+}
+
+// WithinDuration checks if the field is at most d away from the current time, either in the past or in the future
+// See [TimeValidator.Clock]
+func (v *TimeValidator) WithinDuration(d time.Duration, msg ...string) *TimeValidator {
+	cmsg := optional(msg)
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		diff := e.now().Sub(timeOf(e.field))
+		if diff < -d || diff > d {
+			return newValidationError(CodeTimeWithin, map[string]any{"duration": d}, timeWithinErrorMsg, cmsg, e.fieldName, d)
+		}
+		return nil
+	})
+	return v
+}
+
+// Test allows you to run a custom validation function
+//
+// The function should have the signature:
+//
+//	func(ctx corretto.Context, value time.Time) error
+func (v *TimeValidator) Test(f CustomValidationFunc[time.Time]) *TimeValidator {
+	v.validations = append(v.validations, func(e *evalContext) error {
+		return customError(f(e.ctx, timeOf(e.field)))
+	})
+	return v
+}
+
+// Default sets the field to the provided value when it's the zero time or a nil pointer, before running the validations
+//
+// When a pointer is passed to Parse the field of the struct is updated, the validations see the default value either way
+func (v *TimeValidator) Default(value time.Time) *TimeValidator {
+	return v.DefaultFunc(func() time.Time { return value })
+}
+
+// DefaultFunc behaves the same as [TimeValidator.Default] but the value is computed by f every time it's needed
+//
+//	"CreatedAt": corretto.Field().Time().DefaultFunc(time.Now),
+func (v *TimeValidator) DefaultFunc(f func() time.Time) *TimeValidator {
+	v.setDefault(func() any { return f() }, reflect.TypeFor[time.Time]())
+	return v
+}
+
+// DateTime checks if the field is a date formatted according to the provided layout (see [time.Parse]),
+// if the layout is empty it uses [time.RFC3339]
+//
+//	corretto.Field().String().DateTime(time.DateOnly)
+//
+// if the string is empty, it will not return error, use [StringValidator.NonEmpty] to check for empty strings
+func (v *StringValidator) DateTime(layout string, msg ...string) *StringValidator {
+	cmsg := optional(msg)
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	v.validations = append(v.validations, func(e *evalContext) error {
+		if e.field.String() == "" {
+			return nil
+		}
+		if _, err := time.Parse(layout, e.field.String()); err != nil {
+			return newValidationError(CodeStringDateTime, map[string]any{"layout": layout}, notADateTimeErrorMsg, cmsg, e.fieldName, layout)
+		}
+		return nil
+	})
+	return v
+}
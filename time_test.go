@@ -0,0 +1,177 @@
+package corretto
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestTime(t *testing.T) {
+	schema := Schema{
+		"Field1": Field().Time(),
+	}
+
+	tests := []struct {
+		name        string
+		value       any
+		expectError bool
+	}{
+		{"zero time", time.Time{}, false},
+		{"time", time.Now(), false},
+		{"string", "2024-01-01", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Parse(struct{ Field1 any }{Field1: tt.value})
+			if tt.expectError && err == nil {
+				t.Errorf("Parse() should have returned an error")
+			}
+		})
+	}
+
+	t.Run("unexported field", func(t *testing.T) {
+		err := schema.Parse(struct{ Field1 time.Time }{})
+		if err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+
+		err = Schema{"field1": Field().Time()}.Parse(struct{ field1 time.Time }{})
+		var defErr *SchemaDefinitionError
+		if !errors.As(err, &defErr) {
+			t.Errorf("Parse() should have returned a SchemaDefinitionError, got %v", err)
+		}
+	})
+}
+
+func TestTimeRules(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	tests := []struct {
+		name        string
+		validator   validator
+		value       time.Time
+		expectError bool
+		code        string
+	}{
+		{"not zero with zero time", Field().Time().NotZero(), time.Time{}, true, CodeTimeNotZero},
+		{"not zero with time", Field().Time().NotZero(), now, false, ""},
+		{"before", Field().Time().Before(now), now.Add(-time.Second), false, ""},
+		{"before with same time", Field().Time().Before(now), now, true, CodeTimeBefore},
+		{"after", Field().Time().After(now), now.Add(time.Second), false, ""},
+		{"after with earlier time", Field().Time().After(now), now.Add(-time.Second), true, CodeTimeAfter},
+		{"between with start", Field().Time().Between(now, now.Add(time.Hour)), now, false, ""},
+		{"between with end", Field().Time().Between(now, now.Add(time.Hour)), now.Add(time.Hour), false, ""},
+		{"between with later time", Field().Time().Between(now, now.Add(time.Hour)), now.Add(2 * time.Hour), true, CodeTimeBetween},
+		{"in past", Field().Time().Clock(clock).InPast(), now.Add(-time.Hour), false, ""},
+		{"in past with future time", Field().Time().Clock(clock).InPast(), now.Add(time.Hour), true, CodeTimeInPast},
+		{"in future", Field().Time().Clock(clock).InFuture(), now.Add(time.Hour), false, ""},
+		{"in future with past time", Field().Time().Clock(clock).InFuture(), now.Add(-time.Hour), true, CodeTimeInFuture},
+		{"within duration in the past", Field().Time().Clock(clock).WithinDuration(time.Minute), now.Add(-time.Minute), false, ""},
+		{"within duration in the future", Field().Time().Clock(clock).WithinDuration(time.Minute), now.Add(time.Minute), false, ""},
+		{"outside duration", Field().Time().Clock(clock).WithinDuration(time.Minute), now.Add(-time.Hour), true, CodeTimeWithin},
+		{"clock set after the rule", Field().Time().InPast().Clock(clock), now.Add(time.Hour), true, CodeTimeInPast},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Schema{"Field1": tt.validator}.Parse(struct{ Field1 time.Time }{Field1: tt.value})
+			if !tt.expectError {
+				if err != nil {
+					t.Errorf("Parse() should have returned nil, got %v", err)
+				}
+				return
+			}
+
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) || vErr.Code != tt.code {
+				t.Errorf("Parse() should have returned an error with code %s, got %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestTimeClockOnCopies(t *testing.T) {
+	type Event struct {
+		At time.Time
+	}
+
+	past := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	event := Event{At: time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	original := Schema{"At": Field().Time().InPast()}
+	c := original.Pick("At")
+	c["At"].(*TimeValidator).Clock(func() time.Time { return past })
+
+	var vErr *ValidationError
+	if err := c.Parse(event); !errors.As(err, &vErr) || vErr.Code != CodeTimeInPast {
+		t.Errorf("the copy should have used its clock, got %v", err)
+	}
+	if err := original.Parse(event); err != nil {
+		t.Errorf("the original should have kept its clock, got %v", err)
+	}
+
+	original["At"].(*TimeValidator).Clock(func() time.Time { return past })
+	if err := original.Parse(event); !errors.As(err, &vErr) || vErr.Code != CodeTimeInPast {
+		t.Errorf("the original should have used its new clock, got %v", err)
+	}
+	c["At"].(*TimeValidator).Clock(time.Now)
+	if err := c.Parse(event); err != nil {
+		t.Errorf("the clock of the original should not have changed the copy, got %v", err)
+	}
+}
+
+func TestTimeCustomValidation(t *testing.T) {
+	type Event struct {
+		Start time.Time
+		End   time.Time
+	}
+
+	schema := Schema{
+		"End": Field().Time().Test(func(ctx Context, value time.Time) error {
+			if !value.After(ctx.(Event).Start) {
+				return fmt.Errorf("End must be after Start")
+			}
+			return nil
+		}),
+	}
+
+	now := time.Now()
+	if err := schema.Parse(Event{Start: now, End: now.Add(time.Hour)}); err != nil {
+		t.Errorf("Parse() should have returned nil, got %v", err)
+	}
+	if err := schema.Parse(Event{Start: now, End: now.Add(-time.Hour)}); err == nil {
+		t.Errorf("Parse() should have returned an error")
+	}
+}
+
+func TestStringDateTime(t *testing.T) {
+	tests := []struct {
+		name        string
+		layout      string
+		value       string
+		expectError bool
+	}{
+		{"empty string", "", "", false},
+		{"RFC 3339 by default", "", "2024-06-15T12:00:00Z", false},
+		{"invalid RFC 3339", "", "2024-06-15", true},
+		{"custom layout", time.DateOnly, "2024-06-15", false},
+		{"invalid custom layout", time.DateOnly, "15/06/2024", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := Schema{"Field1": Field().String().DateTime(tt.layout)}
+
+			err := schema.Parse(struct{ Field1 string }{Field1: tt.value})
+			if tt.expectError && err == nil {
+				t.Errorf("Parse() should have returned an error")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Parse() should have returned nil, got %v", err)
+			}
+		})
+	}
+}
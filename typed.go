@@ -0,0 +1,211 @@
+package corretto
+
+import (
+	"maps"
+	"reflect"
+)
+
+// TypedSchema is a [Schema] bound to the struct type T
+//
+// Fields are declared with selectors instead of string keys (see [TypedField]), so renaming a field of the struct
+// is caught by the compiler and [TypedSchema.Parse] only accepts values of type T.
+//
+// Example:
+//
+//	schema := corretto.NewTypedSchema[User]()
+//	corretto.TypedField(schema, func(u *User) *string { return &u.FirstName }, corretto.Field("Name").String().MinLength(3))
+//	corretto.TypedField(schema, func(u *User) *int { return &u.Age }, corretto.Field().Number().Min(18))
+//
+//	err := schema.Parse(user)
+//
+// The zero value is an empty schema ready to use
+type TypedSchema[T any] struct {
+	schema ObjectSchema
+	err    error // The first error found while declaring the fields, returned when parsing
+}
+
+// NewTypedSchema creates an empty [TypedSchema] for the struct type T
+func NewTypedSchema[T any]() *TypedSchema[T] {
+	return &TypedSchema[T]{}
+}
+
+// TypedField adds the validator for the field of T whose address is returned by the selector
+//
+//	corretto.TypedField(schema, func(u *User) *string { return &u.Email }, corretto.Field().String().Email())
+//
+// Since the selector must return a pointer, returning the value of the field instead of its address doesn't compile.
+// If it returns the address of something other than a field of the struct, a [SchemaDefinitionError] is returned when parsing
+func TypedField[T any, F any](s *TypedSchema[T], selector func(*T) *F, v validator) *TypedSchema[T] {
+	return s.Field(func(value *T) any { return selector(value) }, v)
+}
+
+// Field adds the validator for the field of T returned by the selector, prefer [TypedField] which checks the selector at compile time
+//
+// The selector must return the address of a field of the struct it receives, e.g.
+//
+//	func(u *User) any { return &u.Email }
+//
+// If it doesn't, a [SchemaDefinitionError] is returned when parsing
+func (s *TypedSchema[T]) Field(selector func(*T) any, v validator) *TypedSchema[T] {
+	key, err := fieldKey(selector)
+	if err != nil {
+		if s.err == nil {
+			s.err = err
+		}
+		return s
+	}
+
+	if s.schema.schema == nil {
+		s.schema.schema = Schema{}
+	}
+	s.schema.schema[key] = v
+	return s
+}
+
+// Test adds a validation of the whole value that runs after the fields are validated, see [Schema.Refine]
+//
+// The function receives the value as T, so cross-field validations are checked by the compiler
+//
+//	schema.Test(func(u User) error {
+//		if u.Age < 18 && u.HasLicense {
+//			return errors.New("User must be at least 18 to have a license")
+//		}
+//		return nil
+//	})
+func (s *TypedSchema[T]) Test(f func(value T) error) *TypedSchema[T] {
+	s.schema = s.schema.Refine(func(ctx Context) error {
+		value, err := contextOf[T](ctx)
+		if err != nil {
+			return err
+		}
+		return f(value)
+	})
+	return s
+}
+
+// Schema returns a copy of the underlying schema, use it to nest the typed schema in other schemas
+//
+//	"Address": corretto.Field().Schema(addressSchema.Schema()),
+func (s *TypedSchema[T]) Schema() ObjectSchema {
+	return s.schema.withFields(maps.Clone(s.schema.schema))
+}
+
+// Compile behaves the same as [Schema.Compile] using T as the struct type
+func (s *TypedSchema[T]) Compile() (*CompiledSchema, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return CompileFor[T](s.schema)
+}
+
+// Parse behaves the same as [Schema.Parse]
+func (s *TypedSchema[T]) Parse(value T) error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.schema.Parse(value)
+}
+
+// ParseAll behaves the same as [Schema.ParseAll]
+func (s *TypedSchema[T]) ParseAll(value T) error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.schema.ParseAll(value)
+}
+
+// MustParse behaves the same as [TypedSchema.Parse] but panics if any of the validations fail
+func (s *TypedSchema[T]) MustParse(value T) {
+	err := s.Parse(value)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Typed adapts a custom validation function that receives the context as T,
+// so that cross-field validations don't need to type assert the [Context]
+//
+// Example:
+//
+//	"HasLicense": corretto.Field().Bool().Test(corretto.Typed(func(u User, value bool) error {
+//		if u.Age < 18 && value {
+//			return fmt.Errorf("User must be at least 18 to have a license")
+//		}
+//		return nil
+//	})),
+//
+// It works whether the struct is parsed by value or by reference,
+// if the context is of any other type it returns a [SchemaDefinitionError]
+func Typed[T any, V any](f func(ctx T, value V) error) CustomValidationFunc[V] {
+	return func(ctx Context, value V) error {
+		c, err := contextOf[T](ctx)
+		if err != nil {
+			return err
+		}
+		return f(c, value)
+	}
+}
+
+// contextOf returns the context as T, whether the struct is parsed by value or by reference
+func contextOf[T any](ctx Context) (T, error) {
+	switch c := ctx.(type) {
+	case T:
+		return c, nil
+	case *T:
+		return *c, nil
+	}
+	var zero T
+	return zero, newSchemaDefinitionError("", "expected context of type %v, got %T", reflect.TypeFor[T](), ctx)
+}
+
+// fieldKey returns the name of the field of T whose address is returned by the selector
+func fieldKey[T any](selector func(*T) any) (string, error) {
+	var zero T
+	base := reflect.ValueOf(&zero).Elem()
+	t := base.Type()
+	if t.Kind() != reflect.Struct {
+		return "", newSchemaDefinitionError("", "schema can only be used with structs, got %v", t)
+	}
+
+	// The selector can reach the fields promoted through embedded pointers, as long as they are allocated
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.Anonymous || f.Type.Kind() != reflect.Ptr {
+			continue
+		}
+		if fv, err := base.FieldByIndexErr(f.Index); err == nil && fv.IsNil() && fv.CanSet() {
+			fv.Set(reflect.New(f.Type.Elem()))
+		}
+	}
+
+	ptr, err := selectedField(selector, &zero)
+	if err != nil {
+		return "", err
+	}
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() {
+		return "", newSchemaDefinitionError("", "selector must return the address of a field of %v, got %v", t, ptr.Type())
+	}
+
+	for _, f := range reflect.VisibleFields(t) {
+		// Fields promoted through a nil embedded pointer don't have an address
+		fv, err := base.FieldByIndexErr(f.Index)
+		if err != nil {
+			continue
+		}
+		if fv.UnsafeAddr() == ptr.Pointer() && f.Type == ptr.Type().Elem() {
+			return f.Name, nil
+		}
+	}
+
+	return "", newSchemaDefinitionError("", "selector must return the address of a field of %v", t)
+}
+
+// selectedField returns the value returned by the selector, or an error if it panics,
+// e.g. going through an embedded pointer that can't be allocated because it's unexported
+func selectedField[T any](selector func(*T) any, zero *T) (ptr reflect.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = newSchemaDefinitionError("", "selector of %v panicked: %v", reflect.TypeFor[T](), r)
+		}
+	}()
+	return reflect.ValueOf(selector(zero)), nil
+}
@@ -0,0 +1,205 @@
+package corretto
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestTypedSchema(t *testing.T) {
+	type Base struct {
+		ID string
+	}
+
+	type User struct {
+		Base
+		Name       string
+		Age        int
+		HasLicense bool
+	}
+
+	schema := NewTypedSchema[User]().
+		Field(func(u *User) any { return &u.ID }, Field().String().NonEmpty()).
+		Field(func(u *User) any { return &u.Name }, Field().String().MinLength(3)).
+		Field(func(u *User) any { return &u.Age }, Field().Number().NonNegative()).
+		Field(func(u *User) any { return &u.HasLicense }, Field().Bool().Test(Typed(func(u User, value bool) error {
+			if u.Age < 18 && value {
+				return fmt.Errorf("User must be at least 18 to have a license")
+			}
+			return nil
+		})))
+
+	tests := []struct {
+		name        string
+		user        User
+		expectedMsg string
+	}{
+		{"valid user", User{Base: Base{ID: "1"}, Name: "John", Age: 30, HasLicense: true}, ""},
+		{"promoted field", User{Name: "John", Age: 30}, "ID cannot be empty"},
+		{"invalid field", User{Base: Base{ID: "1"}, Name: "Jo", Age: 30}, "Name must be at least 3 characters long"},
+		{"typed cross-field validation", User{Base: Base{ID: "1"}, Name: "John", Age: 16, HasLicense: true}, "User must be at least 18 to have a license"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Parse(tt.user)
+			if tt.expectedMsg == "" {
+				if err != nil {
+					t.Errorf("Parse() should have returned nil, got %v", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tt.expectedMsg {
+				t.Errorf("expected: %s, got: %v", tt.expectedMsg, err)
+			}
+		})
+	}
+
+	t.Run("compiles for T", func(t *testing.T) {
+		if _, err := schema.Compile(); err != nil {
+			t.Errorf("Compile() should have returned nil, got %v", err)
+		}
+	})
+}
+
+func TestTypedField(t *testing.T) {
+	type User struct {
+		Name       *string
+		Age        int
+		HasLicense bool
+	}
+
+	var schema TypedSchema[User]
+	TypedField(&schema, func(u *User) **string { return &u.Name }, Field().String().MinLength(3))
+	TypedField(&schema, func(u *User) *int { return &u.Age }, Field().Number().NonNegative())
+	schema.Test(func(u User) error {
+		if u.Age < 18 && u.HasLicense {
+			return fmt.Errorf("User must be at least 18 to have a license")
+		}
+		return nil
+	})
+
+	name := "John"
+	tests := []struct {
+		name        string
+		user        User
+		expectedMsg string
+	}{
+		{"valid user", User{Name: &name, Age: 30, HasLicense: true}, ""},
+		{"invalid field", User{Name: new(string), Age: 30}, "Name must be at least 3 characters long"},
+		{"typed test", User{Name: &name, Age: 16, HasLicense: true}, "User must be at least 18 to have a license"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, parse := range []func(any) error{func(v any) error { return schema.Parse(v.(User)) }, schema.Schema().Parse} {
+				err := parse(tt.user)
+				if tt.expectedMsg == "" {
+					if err != nil {
+						t.Errorf("Parse() should have returned nil, got %v", err)
+					}
+					continue
+				}
+				if err == nil || err.Error() != tt.expectedMsg {
+					t.Errorf("expected: %s, got: %v", tt.expectedMsg, err)
+				}
+			}
+		})
+	}
+
+	t.Run("the zero value is empty", func(t *testing.T) {
+		var empty TypedSchema[User]
+		if err := empty.Parse(User{}); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+	})
+}
+
+func TestTypedSchemaInvalidSelector(t *testing.T) {
+	type User struct {
+		Name string
+	}
+
+	other := ""
+	schema := NewTypedSchema[User]().
+		Field(func(u *User) any { return &other }, Field().String())
+
+	var defErr *SchemaDefinitionError
+	if err := schema.Parse(User{Name: "John"}); !errors.As(err, &defErr) {
+		t.Errorf("Parse() should have returned a SchemaDefinitionError, got %v", err)
+	}
+}
+
+func TestTypedFieldEmbeddedPointer(t *testing.T) {
+	type Base struct {
+		ID int
+	}
+	type User struct {
+		*Base
+		Name string
+	}
+
+	var schema TypedSchema[User]
+	TypedField(&schema, func(u *User) *int { return &u.ID }, Field().Number().Positive())
+
+	if err := schema.Parse(User{Base: &Base{ID: 1}}); err != nil {
+		t.Errorf("Parse() should have returned nil, got %v", err)
+	}
+	var vErr *ValidationError
+	if err := schema.Parse(User{Base: &Base{ID: -1}}); !errors.As(err, &vErr) || vErr.Key != "ID" {
+		t.Errorf("expected an error for ID, got %v", err)
+	}
+
+	t.Run("unexported embedded pointer", func(t *testing.T) {
+		type base struct {
+			ID int
+		}
+		type Admin struct {
+			*base
+		}
+
+		var schema TypedSchema[Admin]
+		TypedField(&schema, func(a *Admin) *int { return &a.ID }, Field().Number().Positive())
+
+		var defErr *SchemaDefinitionError
+		if err := schema.Parse(Admin{base: &base{ID: 1}}); !errors.As(err, &defErr) {
+			t.Errorf("Parse() should have returned a SchemaDefinitionError, got %v", err)
+		}
+	})
+}
+
+func TestTyped(t *testing.T) {
+	type User struct {
+		Password        string
+		ConfirmPassword string
+	}
+
+	schema := Schema{
+		"ConfirmPassword": Field().String().Test(Typed(func(u User, value string) error {
+			if u.Password != value {
+				return fmt.Errorf("passwords don't match")
+			}
+			return nil
+		})),
+	}
+
+	t.Run("works with values and pointers", func(t *testing.T) {
+		u := User{Password: "secret", ConfirmPassword: "other"}
+		if err := schema.Parse(u); err == nil {
+			t.Errorf("Parse() should have returned an error")
+		}
+		if err := schema.Parse(&u); err == nil {
+			t.Errorf("Parse() should have returned an error")
+		}
+	})
+
+	t.Run("returns SchemaDefinitionError on context mismatch", func(t *testing.T) {
+		err := schema.Parse(struct{ ConfirmPassword string }{ConfirmPassword: "secret"})
+
+		var defErr *SchemaDefinitionError
+		if !errors.As(err, &defErr) || defErr.Path != "ConfirmPassword" {
+			t.Errorf("Parse() should have returned a SchemaDefinitionError for ConfirmPassword, got %v", err)
+		}
+	})
+}
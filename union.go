@@ -0,0 +1,213 @@
+package corretto
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+const unionErrorMsg = "%v must match one of the alternatives"
+
+// Codes of the union validations, see [ValidationError]
+const (
+	CodeUnion              = "union"
+	CodeUnionDiscriminator = "union.discriminator"
+)
+
+// newUnionError returns the error of a value that doesn't match any of the alternatives,
+// the errors of each alternative are wrapped so that they can be inspected with [errors.As]
+func newUnionError(errs ValidationErrors, cmsg string, name string) *ValidationError {
+	err := newValidationError(CodeUnion, map[string]any{"alternatives": len(errs)}, unionErrorMsg, cmsg, name)
+	err.Err = errs
+	return err
+}
+
+// union holds the alternatives of a union schema, so that the JSON data decoded into a struct can be checked
+// against the strict ones (see [ObjectSchema.validate]), which only see the keys of map documents when parsing
+type union struct {
+	alternatives []ObjectSchema
+	selected     func(value any) (ObjectSchema, bool) // Returns the alternative that applies to the value, if any
+}
+
+// withUnion returns a schema that runs the check of the union, see [ObjectSchema.withCheck]
+func withUnion(check schemaCheck, tc typeCheck, u union) ObjectSchema {
+	o := ObjectSchema{}.withCheck(check, tc)
+	o.options.unions = []union{u}
+	return o
+}
+
+// firstPassing runs the alternatives of a union in order until one passes. If none does it returns the errors of all of them,
+// which are never nil, otherwise the error that stopped them (e.g. a [SchemaDefinitionError]) if any.
+//
+// The alternatives are tried without changing the value, so that a failing one doesn't leave its transformations and defaults behind,
+// then the one that passes is run again with the evaluation e to apply them
+func firstPassing(e *evalContext, n int, run func(i int, e *evalContext) error) (ValidationErrors, error) {
+	errs := make(ValidationErrors, 0, n)
+	for i := 0; i < n; i++ {
+		t := *e
+		t.trial = &trial{}
+		err := run(i, &t)
+		if err == nil {
+			if t.trial.changed {
+				return nil, run(i, e)
+			}
+			return nil, nil
+		}
+		if isSchemaDefinitionError(err) {
+			return nil, err
+		}
+		errs = append(errs, err)
+	}
+	return errs, nil
+}
+
+// Union checks if the field is valid according to any of the provided validators,
+// if none of them passes the error (with code [CodeUnion]) wraps the errors of every alternative
+//
+//	"ID": corretto.Field().Union(
+//		corretto.Field().String().Uuid(),
+//		corretto.Field().Number().Positive(),
+//	),
+//
+// The alternatives run in order and the first one that passes stops the validation,
+// only the transformations and defaults (e.g. [StringValidator.Trim]) of the one that passes are applied
+func (v *BaseValidator) Union(validators ...validator) *BaseValidator {
+	v.typeChecks = append(v.typeChecks, func(t reflect.Type, path string) error {
+		var first error
+		for _, alt := range validators {
+			err := alt.getBaseValidator().compile(t, path)
+			if err == nil {
+				return nil
+			}
+			if first == nil {
+				first = err
+			}
+		}
+		return first
+	})
+	v.validations = append(v.validations, func(e *evalContext) error {
+		errs, err := firstPassing(e, len(validators), func(i int, e *evalContext) error {
+			bv := validators[i].getBaseValidator()
+			return bv.check(e.element(bv, e.field, e.path, e.fieldName))
+		})
+		if errs == nil {
+			return err
+		}
+		return newUnionError(errs, "", e.fieldName)
+	})
+	return v
+}
+
+// OneOfSchemas returns a schema that passes if the value is valid according to any of the provided schemas,
+// if none of them passes the error (with code [CodeUnion]) wraps the errors of every alternative
+//
+//	contactSchema := corretto.OneOfSchemas(emailContactSchema, phoneContactSchema)
+//
+// When the JSON data decoded by [Schema.Unmarshal] into a struct is checked against a strict alternative (see [Schema.Strict]),
+// the alternative is the first one whose fields are valid, since the keys of the data are not known while parsing the struct
+func OneOfSchemas(schemas ...Shape) ObjectSchema {
+	check := func(value any, parent *evalContext) error {
+		errs, err := firstPassing(parent, len(schemas), func(i int, parent *evalContext) error {
+			return schemas[i].object().parse(value, parent)
+		})
+		if errs == nil {
+			return err
+		}
+		return parent.schemaError(newUnionError(errs, "", parent.name()))
+	}
+	typeCheck := func(t reflect.Type, path string) error {
+		for _, s := range schemas {
+			if err := s.object().compile(t, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	alternatives := make([]ObjectSchema, 0, len(schemas))
+	for _, s := range schemas {
+		alternatives = append(alternatives, s.object())
+	}
+	selected := func(value any) (ObjectSchema, bool) {
+		for _, alt := range alternatives {
+			// The value is only inspected, it must not be changed
+			if alt.parse(value, &evalContext{trial: &trial{}}) == nil {
+				return alt, true
+			}
+		}
+		return ObjectSchema{}, false
+	}
+
+	return withUnion(check, typeCheck, union{alternatives, selected})
+}
+
+// DiscriminatedUnion returns a schema that validates the value with the schema matching the value of the discriminator field
+//
+//	eventSchema := corretto.DiscriminatedUnion("Type", map[string]corretto.Schema{
+//		"click":  clickSchema,
+//		"scroll": scrollSchema,
+//	})
+//
+// The discriminator is compared using its string representation, so it can be any type (e.g. a string or an int enum).
+// If it's nil an "is required" error is reported, if it has no matching schema an error with code [CodeUnionDiscriminator]
+//
+// A strict alternative (see [Schema.Strict]) reports the keys it doesn't declare, with [Schema.Unmarshal] too when the JSON data is decoded into a struct
+func DiscriminatedUnion[S Shape](discriminator string, schemas map[string]S, msg ...string) ObjectSchema {
+	cmsg := optional(msg)
+	allowed := make([]string, 0, len(schemas))
+	for key := range schemas {
+		allowed = append(allowed, key)
+	}
+	slices.Sort(allowed)
+
+	check := func(value any, parent *evalContext) error {
+		field, ok := siblingOf(value, discriminator)
+		if !ok {
+			return newSchemaDefinitionError(joinPath(parent.path, discriminator), "discriminator %s not found in %T", discriminator, value)
+		}
+
+		e := &evalContext{ctx: value, field: field, key: discriminator, fieldName: discriminator, path: joinPath(parent.path, discriminator)}
+		if !field.IsValid() {
+			err := newValidationError(CodeRequired, nil, requiredErrorMsg, "", discriminator)
+			e.decorate(err)
+			return err
+		}
+
+		s, ok := schemas[fmt.Sprint(valueOf(field))]
+		if !ok {
+			err := newValidationError(CodeUnionDiscriminator, map[string]any{"allowed": allowed}, oneOfErrorMsg, cmsg, discriminator, allowed)
+			e.decorate(err)
+			return err
+		}
+		return s.object().parse(value, parent)
+	}
+	typeCheck := func(t reflect.Type, path string) error {
+		if _, ok := t.FieldByName(discriminator); !ok {
+			return newSchemaDefinitionError(joinPath(path, discriminator), "discriminator %s not found in struct %s", discriminator, t.Name())
+		}
+		for _, key := range allowed {
+			if err := schemas[key].object().compile(t, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	alternatives := make([]ObjectSchema, 0, len(allowed))
+	for _, key := range allowed {
+		alternatives = append(alternatives, schemas[key].object())
+	}
+	selected := func(value any) (ObjectSchema, bool) {
+		field, ok := siblingOf(value, discriminator)
+		if !ok || !field.IsValid() {
+			return ObjectSchema{}, false
+		}
+		s, ok := schemas[fmt.Sprint(valueOf(field))]
+		if !ok {
+			return ObjectSchema{}, false
+		}
+		return s.object(), true
+	}
+
+	return withUnion(check, typeCheck, union{alternatives, selected})
+}
@@ -0,0 +1,274 @@
+package corretto
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestUnion(t *testing.T) {
+	schema := Schema{
+		"ID": Field().Union(
+			Field().String().Uuid(),
+			Field().Number().Positive(),
+		),
+	}
+
+	tests := []struct {
+		name        string
+		value       any
+		expectError bool
+	}{
+		{"first alternative", "123e4567-e89b-12d3-a456-426614174000", false},
+		{"second alternative", 42, false},
+		{"invalid first alternative", "not-a-uuid", true},
+		{"invalid second alternative", -1, true},
+		{"no alternative", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Parse(map[string]any{"ID": tt.value})
+			if tt.expectError && err == nil {
+				t.Errorf("Parse() should have returned an error")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Parse() should have returned nil, got %v", err)
+			}
+		})
+	}
+
+	t.Run("reports the errors of every alternative", func(t *testing.T) {
+		err := schema.Parse(map[string]any{"ID": -1})
+
+		var vErr *ValidationError
+		if !errors.As(err, &vErr) || vErr.Code != CodeUnion || vErr.Path != "ID" {
+			t.Fatalf("expected a %s error for ID, got %v", CodeUnion, err)
+		}
+		if err.Error() != "ID must match one of the alternatives" {
+			t.Errorf("unexpected message %q", err.Error())
+		}
+
+		errs, ok := vErr.Err.(ValidationErrors)
+		if !ok || len(errs) != 2 {
+			t.Fatalf("expected the errors of the 2 alternatives, got %v", vErr.Err)
+		}
+		var altErr *ValidationError
+		if !errors.As(errs[0], &altErr) || altErr.Code != CodeNotAString {
+			t.Errorf("expected the type error of the first alternative, got %v", errs[0])
+		}
+		if !errors.As(errs[1], &altErr) || altErr.Code != CodeNumberPositive {
+			t.Errorf("expected the positive error of the second alternative, got %v", errs[1])
+		}
+	})
+
+	t.Run("applies only the transformations of the passing alternative", func(t *testing.T) {
+		s := Schema{
+			"Code": Field().Union(
+				Field().String().Trim().ToUpper().Length(3),
+				Field().String().Trim().MinLength(5),
+			),
+		}
+
+		v := &struct{ Code string }{Code: " italy "}
+		if err := s.Parse(v); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+		if v.Code != "italy" {
+			t.Errorf("expected italy, got %q", v.Code)
+		}
+
+		v.Code = " ita "
+		if err := s.Parse(v); err != nil || v.Code != "ITA" {
+			t.Errorf("expected ITA, got %q (%v)", v.Code, err)
+		}
+
+		doc := map[string]any{"Code": " ita "}
+		if err := s.Parse(&doc); err != nil || doc["Code"] != "ITA" {
+			t.Errorf("expected ITA in the map document, got %q (%v)", doc["Code"], err)
+		}
+	})
+
+	t.Run("compiles if any alternative matches", func(t *testing.T) {
+		if _, err := schema.Compile(reflect.TypeFor[struct{ ID int }]()); err != nil {
+			t.Errorf("Compile() should have returned nil, got %v", err)
+		}
+
+		var defErr *SchemaDefinitionError
+		if _, err := schema.Compile(reflect.TypeFor[struct{ ID bool }]()); !errors.As(err, &defErr) {
+			t.Errorf("expected a SchemaDefinitionError, got %v", err)
+		}
+	})
+}
+
+func TestOneOfSchemas(t *testing.T) {
+	type Contact struct {
+		Email *string
+		Phone *string
+	}
+
+	schema := OneOfSchemas(
+		Schema{"Email": Field().String().Email()},
+		Schema{"Phone": Field().String().MinLength(6)},
+	)
+
+	email := "john@doe.com"
+	phone := "555"
+
+	if err := schema.Parse(Contact{Email: &email}); err != nil {
+		t.Errorf("Parse() should have returned nil, got %v", err)
+	}
+	if err := schema.Parse(Contact{Phone: &email}); err != nil {
+		t.Errorf("Parse() should have returned nil, got %v", err)
+	}
+
+	err := schema.ParseAll(Contact{Phone: &phone})
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) || vErr.Code != CodeUnion || vErr.Field != "document" {
+		t.Fatalf("expected a %s error, got %v", CodeUnion, err)
+	}
+	if errs := vErr.Err.(ValidationErrors); len(errs) != 2 {
+		t.Errorf("expected the errors of the 2 alternatives, got %v", errs)
+	}
+
+	t.Run("applies only the defaults of the passing alternative", func(t *testing.T) {
+		s := OneOfSchemas(
+			Schema{"Email": Field().String().Default("none").Email()},
+			Schema{"Phone": Field().String().MinLength(3)},
+		)
+
+		doc := map[string]any{"Phone": "5551234"}
+		if err := s.Parse(&doc); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+		if _, ok := doc["Email"]; ok {
+			t.Errorf("the default of the failing alternative should not have been applied, got %v", doc)
+		}
+	})
+
+	t.Run("strict alternatives with Unmarshal", func(t *testing.T) {
+		s := OneOfSchemas(
+			Schema{"Email": Field().String().Email()}.Strict(),
+			Schema{"Phone": Field().String().MinLength(6)},
+		)
+
+		var vErr *ValidationError
+		err := s.Unmarshal([]byte(`{"Email": "john@doe.com", "Admin": true}`), &Contact{})
+		if !errors.As(err, &vErr) || vErr.Code != CodeUnknownKeys || !slices.Equal(vErr.Params["keys"].([]string), []string{"Admin"}) {
+			t.Errorf("expected the unknown keys [Admin], got %v", err)
+		}
+		if err := s.Unmarshal([]byte(`{"Phone": "5551234", "Admin": true}`), &Contact{}); err != nil {
+			t.Errorf("Unmarshal() should have returned nil, got %v", err)
+		}
+	})
+}
+
+func TestDiscriminatedUnion(t *testing.T) {
+	type Event struct {
+		Type   string
+		X      *int
+		Offset *int
+	}
+
+	schema := DiscriminatedUnion("Type", map[string]Schema{
+		"click":  {"X": Field().Number().NonNegative()},
+		"scroll": {"Offset": Field().Number()},
+	})
+
+	x := 10
+	negative := -1
+
+	tests := []struct {
+		name         string
+		value        any
+		expectedCode string
+		expectedPath string
+	}{
+		{"matching schema", Event{Type: "click", X: &x}, "", ""},
+		{"other schema", Event{Type: "scroll", Offset: &negative}, "", ""},
+		{"invalid value", Event{Type: "click", X: &negative}, CodeNumberNonNegative, "X"},
+		{"missing field of the matching schema", Event{Type: "scroll", X: &x}, CodeRequired, "Offset"},
+		{"unknown discriminator", Event{Type: "hover"}, CodeUnionDiscriminator, "Type"},
+		{"document", map[string]any{"Type": "click", "X": 3}, "", ""},
+		{"document without discriminator", map[string]any{"X": 3}, CodeRequired, "Type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Parse(tt.value)
+			if tt.expectedCode == "" {
+				if err != nil {
+					t.Errorf("Parse() should have returned nil, got %v", err)
+				}
+				return
+			}
+
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) || vErr.Code != tt.expectedCode || vErr.Path != tt.expectedPath {
+				t.Errorf("expected a %s error for %s, got %v", tt.expectedCode, tt.expectedPath, err)
+			}
+		})
+	}
+
+	t.Run("strict alternatives with Unmarshal", func(t *testing.T) {
+		s := DiscriminatedUnion("Type", map[string]ObjectSchema{
+			"click":  Schema{"Type": Field().String(), "X": Field().Number()}.Strict(),
+			"scroll": Schema{"Type": Field().String(), "Offset": Field().Number()}.Strict(),
+		})
+
+		for _, data := range []string{`{"Type": "click", "X": 1, "Evil": true}`, `{"Type": "click", "Evil": true, "Offset": 1}`} {
+			for _, target := range []any{&Event{}, &map[string]any{}} {
+				var vErr *ValidationError
+				err := s.Unmarshal([]byte(data), target)
+				if !errors.As(err, &vErr) || vErr.Code != CodeUnknownKeys || !slices.Contains(vErr.Params["keys"].([]string), "Evil") {
+					t.Errorf("%s into %T: expected the unknown key Evil, got %v", data, target, err)
+				}
+			}
+		}
+		if err := s.Unmarshal([]byte(`{"Type": "scroll", "Offset": 1}`), &Event{}); err != nil {
+			t.Errorf("Unmarshal() should have returned nil, got %v", err)
+		}
+	})
+
+	t.Run("unknown discriminator message", func(t *testing.T) {
+		err := schema.Parse(Event{Type: "hover"})
+		if err == nil || err.Error() != "Type must be one of [click scroll]" {
+			t.Errorf("unexpected error %v", err)
+		}
+	})
+
+	t.Run("nested and extended", func(t *testing.T) {
+		type Envelope struct {
+			ID    string
+			Event Event
+		}
+
+		s := Schema{
+			"ID":    Field().String().NonEmpty(),
+			"Event": Field().Schema(Schema{"Type": Field().String().NonEmpty()}.Extend(schema)),
+		}
+
+		err := s.ParseAll(Envelope{Event: Event{Type: "click", X: &negative}})
+		var errs ValidationErrors
+		if !errors.As(err, &errs) || len(errs) != 2 {
+			t.Fatalf("expected 2 errors, got %v", err)
+		}
+
+		var vErr *ValidationError
+		if !errors.As(errs[1], &vErr) || vErr.Path != "Event.X" {
+			t.Errorf("expected an error for Event.X, got %v", errs[1])
+		}
+	})
+
+	t.Run("compile", func(t *testing.T) {
+		if _, err := CompileFor[Event](schema); err != nil {
+			t.Errorf("Compile() should have returned nil, got %v", err)
+		}
+
+		var defErr *SchemaDefinitionError
+		if _, err := CompileFor[struct{ Kind string }](schema); !errors.As(err, &defErr) || defErr.Path != "Type" {
+			t.Errorf("expected a SchemaDefinitionError for Type, got %v", err)
+		}
+	})
+}
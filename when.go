@@ -0,0 +1,69 @@
+package corretto
+
+import (
+	"reflect"
+)
+
+// When runs then on the field if the predicate returns true for the value of the provided sibling field, otherwise (if not nil) when it returns false
+//
+//	"VatNumber": corretto.Field().Optional().When("Country", func(country any) bool {
+//		return slices.Contains(euCountries, country.(string))
+//	}, corretto.Field().Required().String().NonEmpty(), nil),
+//
+// The sibling is looked up in the context (the struct or map document that contains the field), pointers are dereferenced
+// and a nil sibling (or a key missing from a map document) is passed to the predicate as nil.
+// If the context has no such field a [SchemaDefinitionError] is returned.
+//
+// The conditional rules run before the other rules of the field and regardless of it being nil,
+// so that the chosen validator decides whether the field is required
+func (v *BaseValidator) When(field string, predicate func(value any) bool, then validator, otherwise validator) *BaseValidator {
+	v.typeChecks = append(v.typeChecks, func(t reflect.Type, path string) error {
+		for _, branch := range []validator{then, otherwise} {
+			if branch == nil {
+				continue
+			}
+			if err := branch.getBaseValidator().compile(t, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	v.conditions = append(v.conditions, func(e *evalContext) error {
+		sibling, ok := siblingOf(e.ctx, field)
+		if !ok {
+			return newSchemaDefinitionError(e.path, "When() refers to field %s not found in %T", field, e.ctx)
+		}
+
+		branch := otherwise
+		if predicate(valueOf(sibling)) {
+			branch = then
+		}
+		if branch == nil {
+			return nil
+		}
+		bv := branch.getBaseValidator()
+		return bv.check(e.element(bv, e.field, e.path, e.fieldName))
+	})
+	return v
+}
+
+// checkConditions runs the conditional rules of the field (see [BaseValidator.When]) and then its own rules
+func (v *BaseValidator) checkConditions(e *evalContext) error {
+	var errs ValidationErrors
+	for _, condition := range v.conditions {
+		if err := condition(e); err != nil {
+			if !e.all || isSchemaDefinitionError(err) {
+				return err
+			}
+			errs.add(err)
+		}
+	}
+
+	if err := v.checkValue(e); err != nil {
+		if !e.all || isSchemaDefinitionError(err) {
+			return err
+		}
+		errs.add(err)
+	}
+	return errs.err()
+}
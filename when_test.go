@@ -0,0 +1,125 @@
+package corretto
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestWhen(t *testing.T) {
+	type Order struct {
+		Country   string
+		VatNumber *string
+	}
+
+	euCountries := []string{"IT", "FR", "DE"}
+	isEU := func(country any) bool {
+		c, _ := country.(string)
+		return slices.Contains(euCountries, c)
+	}
+
+	schema := Schema{
+		"Country": Field().String(),
+		"VatNumber": Field().Optional().When("Country", isEU,
+			Field().Required().String().MinLength(8),
+			Field().Optional().String().MaxLength(0),
+		),
+	}
+
+	vat := "IT12345678"
+	short := "IT1"
+
+	tests := []struct {
+		name        string
+		value       Order
+		expectError bool
+		code        string
+	}{
+		{"then passes", Order{Country: "IT", VatNumber: &vat}, false, ""},
+		{"then fails", Order{Country: "IT", VatNumber: &short}, true, CodeStringMinLength},
+		{"then requires a nil field", Order{Country: "FR"}, true, CodeRequired},
+		{"otherwise passes", Order{Country: "US"}, false, ""},
+		{"otherwise fails", Order{Country: "US", VatNumber: &vat}, true, CodeStringMaxLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Parse(tt.value)
+			if !tt.expectError {
+				if err != nil {
+					t.Errorf("Parse() should have returned nil, got %v", err)
+				}
+				return
+			}
+
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) || vErr.Code != tt.code || vErr.Path != "VatNumber" {
+				t.Errorf("expected a %s error for VatNumber, got %v", tt.code, err)
+			}
+		})
+	}
+
+	t.Run("without otherwise", func(t *testing.T) {
+		schema := Schema{
+			"Country":   Field().String(),
+			"VatNumber": Field().Optional().When("Country", isEU, Field().Required(), nil),
+		}
+		if err := schema.Parse(Order{Country: "US"}); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+		if err := schema.Parse(Order{Country: "DE"}); err == nil {
+			t.Errorf("Parse() should have returned an error")
+		}
+	})
+
+	t.Run("runs alongside the rules of the field", func(t *testing.T) {
+		schema := Schema{
+			"Country":   Field().String(),
+			"VatNumber": Field().Optional().When("Country", isEU, Field().Required(), nil).String().MaxLength(4),
+		}
+		errs := schema.ParseAll(Order{Country: "IT", VatNumber: &vat})
+		if errs == nil || len(errs.(ValidationErrors)) != 1 {
+			t.Fatalf("expected 1 error, got %v", errs)
+		}
+
+		err := schema.Parse(Order{Country: "IT", VatNumber: &short})
+		if err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+	})
+
+	t.Run("map documents", func(t *testing.T) {
+		schema := Schema{
+			"vat": Field().Optional().When("country", func(country any) bool { return country != nil }, Field().Required(), nil),
+		}
+		if err := schema.Parse(map[string]any{}); err != nil {
+			t.Errorf("Parse() should have returned nil, got %v", err)
+		}
+		if err := schema.Parse(map[string]any{"country": "IT"}); err == nil {
+			t.Errorf("Parse() should have returned an error")
+		}
+
+		schema = Schema{
+			"vat":  Field().When("country", func(country any) bool { return country == "IT" }, Field().String().Trim(), nil),
+			"note": Field().Optional().When("country", func(country any) bool { return country == "IT" }, Field().String().Default("none"), nil),
+		}
+		doc := map[string]any{"country": "IT", "vat": " IT123 "}
+		if err := schema.Parse(&doc); err != nil {
+			t.Fatalf("Parse() should have returned nil, got %v", err)
+		}
+		if doc["vat"] != "IT123" || doc["note"] != "none" {
+			t.Errorf("Parse() should have updated the document, got %v", doc)
+		}
+	})
+
+	t.Run("missing sibling", func(t *testing.T) {
+		schema := Schema{
+			"VatNumber": Field().Optional().When("Region", isEU, Field().Required(), nil),
+		}
+
+		var defErr *SchemaDefinitionError
+		if err := schema.Parse(Order{}); !errors.As(err, &defErr) || defErr.Path != "VatNumber" {
+			t.Errorf("expected a SchemaDefinitionError for VatNumber, got %v", err)
+		}
+	})
+}